@@ -0,0 +1,66 @@
+package main
+
+import (
+	"cli-dino-game/src/engine"
+	"cli-dino-game/src/entities"
+	"fmt"
+	"io"
+)
+
+// debugBirdCollision prints the bounds of each bird height and whether it hits a
+// dinosaur standing in the same column
+func debugBirdCollision(w io.Writer) {
+	// Create a config
+	config := engine.NewDefaultConfig()
+	config.ScreenWidth = 80
+	config.ScreenHeight = 20
+
+	// Same ground as the game
+	ground := engine.NewGroundModel(config.ScreenHeight, entities.DinosaurHeight(1))
+	groundLevel := ground.DinoGroundY() // Dinosaur at y=15
+	dinosaur := entities.NewDinosaur(groundLevel)
+	actualGroundY := ground.ObstacleGroundY() // Obstacles stand on y=19
+
+	fmt.Fprintf(w, "Screen height: %d\n", config.ScreenHeight)
+	fmt.Fprintf(w, "Ground level (dinosaur Y): %.1f\n", groundLevel)
+	fmt.Fprintf(w, "Dinosaur height: %.1f\n", dinosaur.Height)
+	fmt.Fprintf(w, "Actual ground Y: %.1f\n", actualGroundY)
+	fmt.Fprintf(w, "Dinosaur bounds: %s\n", dinosaur.GetBounds().String())
+
+	// Create different types of birds at the same X position as dinosaur
+	birdLow := entities.NewObstacle(entities.BirdLow, dinosaur.X, actualGroundY, config)
+	birdMid := entities.NewObstacle(entities.BirdMid, dinosaur.X, actualGroundY, config)
+	birdHigh := entities.NewObstacle(entities.BirdHigh, dinosaur.X, actualGroundY, config)
+
+	fmt.Fprintf(w, "\nBird Low bounds: %s\n", birdLow.GetBounds().String())
+	fmt.Fprintf(w, "Bird Mid bounds: %s\n", birdMid.GetBounds().String())
+	fmt.Fprintf(w, "Bird High bounds: %s\n", birdHigh.GetBounds().String())
+
+	// Create game engine to test collision
+	gameEngine := engine.NewGameEngine(config)
+	fmt.Fprintf(w, "Default collision tolerance: %.1f\n", gameEngine.GetCollisionTolerance())
+
+	// Test collisions
+	fmt.Fprintf(w, "\nCollision tests:\n")
+	fmt.Fprintf(w, "Dinosaur vs Bird Low: %t\n", gameEngine.CheckCollision(dinosaur.GetBounds(), birdLow.GetBounds()))
+	fmt.Fprintf(w, "Dinosaur vs Bird Mid: %t\n", gameEngine.CheckCollision(dinosaur.GetBounds(), birdMid.GetBounds()))
+	fmt.Fprintf(w, "Dinosaur vs Bird High: %t\n", gameEngine.CheckCollision(dinosaur.GetBounds(), birdHigh.GetBounds()))
+
+	// Test with no tolerance
+	gameEngine.SetCollisionTolerance(0)
+	fmt.Fprintf(w, "\nWith zero tolerance:\n")
+	fmt.Fprintf(w, "Dinosaur vs Bird Low: %t\n", gameEngine.CheckCollision(dinosaur.GetBounds(), birdLow.GetBounds()))
+	fmt.Fprintf(w, "Dinosaur vs Bird Mid: %t\n", gameEngine.CheckCollision(dinosaur.GetBounds(), birdMid.GetBounds()))
+	fmt.Fprintf(w, "Dinosaur vs Bird High: %t\n", gameEngine.CheckCollision(dinosaur.GetBounds(), birdHigh.GetBounds()))
+
+	// Print ASCII art to visualize
+	fmt.Fprintf(w, "\nDinosaur ASCII art:\n")
+	for i, line := range dinosaur.GetASCIIArt() {
+		fmt.Fprintf(w, "Y=%.1f: %s\n", dinosaur.Y+float64(i), line)
+	}
+
+	fmt.Fprintf(w, "\nBird Low ASCII art:\n")
+	for i, line := range birdLow.GetASCIIArt() {
+		fmt.Fprintf(w, "Y=%.1f: %s\n", birdLow.Y+float64(i), line)
+	}
+}
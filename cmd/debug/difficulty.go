@@ -0,0 +1,31 @@
+package main
+
+import (
+	"cli-dino-game/src/engine"
+	"cli-dino-game/src/spawner"
+	"fmt"
+	"io"
+)
+
+// printDifficultyProgression prints the spawn rate, speed, gap, and bird share
+// the spawner reports at points through a run
+func printDifficultyProgression(w io.Writer) {
+	config := engine.NewDefaultConfig()
+	config.ScreenWidth = 80
+	config.ScreenHeight = 20
+
+	spawnerInstance := spawner.NewObstacleSpawner(config, 80, 19)
+
+	fmt.Fprintf(w, "=== Difficulty Progression Test ===\n")
+	fmt.Fprintf(w, "Base spawn rate: %.2f obstacles/sec\n", config.SpawnRate)
+	fmt.Fprintf(w, "Max spawn rate: %.2f obstacles/sec\n\n", config.SpawnRate*2.0)
+
+	for _, gameTime := range []float64{0, 10, 20, 30, 40, 60, 90, 120, 180} {
+		// Run the spawner forward to this point in the run
+		spawnerInstance.Update(gameTime - spawnerInstance.GetGameTime())
+		state := spawnerInstance.GetDifficultyState()
+
+		fmt.Fprintf(w, "Time: %3.0fs | Spawn: %.2f/s | Speed: %.2fx | MinGap: %.0f | Birds: %.1f%%\n",
+			gameTime, state.SpawnRate, state.SpeedMultiplier, state.MinGap, state.BirdWeight*100)
+	}
+}
@@ -0,0 +1,34 @@
+package main
+
+import (
+	"cli-dino-game/src/engine"
+	"fmt"
+	"io"
+	"math"
+)
+
+// simulateJump prints the peak height and timing of a jump with the default physics
+func simulateJump(w io.Writer) {
+	config := engine.NewDefaultConfig()
+
+	// Jump physics calculation
+	// At peak, velocity = 0, so: 0 = jumpVelocity - gravity * time
+	// time_to_peak = jumpVelocity / gravity
+	timeToPeak := config.JumpVelocity / config.Gravity
+
+	// Height = initial_velocity * time - 0.5 * gravity * time^2
+	// At peak: height = jumpVelocity * timeToPeak - 0.5 * gravity * timeToPeak^2
+	maxHeight := config.JumpVelocity*timeToPeak - 0.5*config.Gravity*math.Pow(timeToPeak, 2)
+
+	fmt.Fprintf(w, "=== Jump Physics Simulation ===\n")
+	fmt.Fprintf(w, "Jump Velocity: %.1f\n", config.JumpVelocity)
+	fmt.Fprintf(w, "Gravity: %.1f\n", config.Gravity)
+	fmt.Fprintf(w, "Time to peak: %.3f seconds\n", timeToPeak)
+	fmt.Fprintf(w, "Maximum jump height: %.1f units\n", maxHeight)
+
+	// Check if this can clear obstacles
+	fmt.Fprintf(w, "\nCan clear obstacles requiring:\n")
+	for _, height := range []float64{3, 4, 5} {
+		fmt.Fprintf(w, "- %.0f units height: %t\n", height, maxHeight >= height)
+	}
+}
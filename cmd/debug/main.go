@@ -0,0 +1,53 @@
+// Command debug prints diagnostics for tuning collisions, jump physics, and
+// difficulty progression outside the game.
+//
+// Usage:
+//
+//	go run ./cmd/debug <collision|difficulty|jump-height|tolerance>
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+)
+
+// commands maps each subcommand name to the diagnostic it runs
+var commands = map[string]func(w io.Writer){
+	"collision":   debugBirdCollision,
+	"difficulty":  printDifficultyProgression,
+	"jump-height": simulateJump,
+	"tolerance":   testCollisionTolerance,
+}
+
+// commandNames returns the subcommand names in alphabetical order
+func commandNames() []string {
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// run dispatches to the subcommand named in args and returns the exit code
+func run(args []string, stdout, stderr io.Writer) int {
+	if len(args) != 1 {
+		fmt.Fprintf(stderr, "usage: debug <command>\ncommands: %v\n", commandNames())
+		return 2
+	}
+
+	command, ok := commands[args[0]]
+	if !ok {
+		fmt.Fprintf(stderr, "unknown command %q\ncommands: %v\n", args[0], commandNames())
+		return 2
+	}
+
+	command(stdout)
+	return 0
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestRunSubcommands(t *testing.T) {
+	tests := []struct {
+		command string
+		want    string
+	}{
+		{"collision", "Dinosaur vs Bird Low"},
+		{"difficulty", "Difficulty Progression"},
+		{"jump-height", "Maximum jump height"},
+		{"tolerance", "Dinosaur vs Large Cactus"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.command, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			if code := run([]string{tt.command}, &stdout, &stderr); code != 0 {
+				t.Fatalf("Expected exit code 0, got %d: %s", code, stderr.String())
+			}
+			if !strings.Contains(stdout.String(), tt.want) {
+				t.Errorf("Expected output to contain %q, got:\n%s", tt.want, stdout.String())
+			}
+		})
+	}
+
+	if len(tests) != len(commands) {
+		t.Errorf("Expected a test for each of the %d commands", len(commands))
+	}
+}
+
+func TestRunUsage(t *testing.T) {
+	for _, args := range [][]string{nil, {"bogus"}, {"collision", "extra"}} {
+		var stdout, stderr bytes.Buffer
+		if code := run(args, &stdout, &stderr); code != 2 {
+			t.Errorf("Expected exit code 2 for %v, got %d", args, code)
+		}
+		if !strings.Contains(stderr.String(), "jump-height") {
+			t.Errorf("Expected usage to list the commands for %v, got %q", args, stderr.String())
+		}
+		if stdout.Len() != 0 {
+			t.Errorf("Expected nothing on stdout for %v", args)
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"cli-dino-game/src/engine"
+	"cli-dino-game/src/entities"
+	"fmt"
+	"io"
+)
+
+// testCollisionTolerance prints which obstacles hit a standing dinosaur with and
+// without collision tolerance, and which jump heights clear a large cactus
+func testCollisionTolerance(w io.Writer) {
+	// Create a config
+	config := engine.NewDefaultConfig()
+	config.ScreenWidth = 80
+	config.ScreenHeight = 20
+
+	// Same ground as the game
+	ground := engine.NewGroundModel(config.ScreenHeight, entities.DinosaurHeight(1))
+	groundLevel := ground.DinoGroundY() // Dinosaur at y=15
+	dinosaur := entities.NewDinosaur(groundLevel)
+	actualGroundY := ground.ObstacleGroundY() // Obstacles stand on y=19
+
+	fmt.Fprintf(w, "=== Collision Tolerance Test (0.8) ===\n")
+	fmt.Fprintf(w, "Dinosaur bounds: %s\n", dinosaur.GetBounds().String())
+
+	// Create different types of obstacles at the same X position as dinosaur
+	obstacles := []struct {
+		name     string
+		obstacle *entities.Obstacle
+	}{
+		{"Small Cactus", entities.NewObstacle(entities.CactusSmall, dinosaur.X, actualGroundY, config)},
+		{"Medium Cactus", entities.NewObstacle(entities.CactusMedium, dinosaur.X, actualGroundY, config)},
+		{"Large Cactus", entities.NewObstacle(entities.CactusLarge, dinosaur.X, actualGroundY, config)},
+		{"Bird Low", entities.NewObstacle(entities.BirdLow, dinosaur.X, actualGroundY, config)},
+		{"Bird Mid", entities.NewObstacle(entities.BirdMid, dinosaur.X, actualGroundY, config)},
+		{"Bird High", entities.NewObstacle(entities.BirdHigh, dinosaur.X, actualGroundY, config)},
+	}
+
+	for _, o := range obstacles {
+		fmt.Fprintf(w, "%s bounds: %s\n", o.name, o.obstacle.GetBounds().String())
+	}
+
+	// Create game engine to test collision
+	gameEngine := engine.NewGameEngine(config)
+	fmt.Fprintf(w, "Default collision tolerance: %.1f\n\n", gameEngine.GetCollisionTolerance())
+
+	// Test collisions with default tolerance (0.8)
+	fmt.Fprintf(w, "Collision tests with tolerance %.1f:\n", gameEngine.GetCollisionTolerance())
+	for _, o := range obstacles {
+		fmt.Fprintf(w, "Dinosaur vs %s: %t\n", o.name, gameEngine.CheckCollision(dinosaur.GetBounds(), o.obstacle.GetBounds()))
+	}
+
+	// Test with no tolerance to see raw collision
+	gameEngine.SetCollisionTolerance(0)
+	fmt.Fprintf(w, "\nWith zero tolerance:\n")
+	for _, o := range obstacles {
+		fmt.Fprintf(w, "Dinosaur vs %s: %t\n", o.name, gameEngine.CheckCollision(dinosaur.GetBounds(), o.obstacle.GetBounds()))
+	}
+
+	// Test with jumping dinosaur vs large cactus to see if we can clear it
+	fmt.Fprintf(w, "\n=== Jump Test ===\n")
+	jumpingDino := entities.NewDinosaur(groundLevel)
+	largeCactus := obstacles[2].obstacle
+
+	// Test different jump heights
+	gameEngine.SetCollisionTolerance(0.8)
+	for _, height := range []float64{1.0, 2.0, 3.0, 4.0, 5.0} {
+		jumpingDino.Y = groundLevel - height
+		collision := gameEngine.CheckCollision(jumpingDino.GetBounds(), largeCactus.GetBounds())
+		fmt.Fprintf(w, "Jump height %.1f (Y=%.1f): vs Large Cactus = %t\n", height, jumpingDino.Y, collision)
+	}
+}
@@ -10,6 +10,7 @@ import (
 // TestCompleteGameCycle tests a complete game cycle from start to finish
 func TestCompleteGameCycle(t *testing.T) {
 	game := NewTestGame()
+	fake := game.useFakeClock()
 
 	// Verify initial state
 	if game.engine.GetState() != engine.StateMenu {
@@ -22,19 +23,15 @@ func TestCompleteGameCycle(t *testing.T) {
 		t.Fatal("Game should transition to playing state")
 	}
 
-	// Simulate gameplay for a reasonable duration
-	startTime := time.Now()
-	gameplayDuration := time.Millisecond * 500 // Half second of gameplay
-
-	for time.Since(startTime) < gameplayDuration {
+	// Simulate half a second of gameplay at ~60 FPS
+	for frame := 0; frame < 30; frame++ {
+		fake.Advance(time.Millisecond * 16)
 		game.update()
 
 		// Simulate occasional jumps
-		if time.Since(startTime)%100*time.Millisecond < 10*time.Millisecond {
+		if frame%6 == 0 {
 			game.handleInput(input.KeySpace)
 		}
-
-		time.Sleep(time.Millisecond * 16) // ~60 FPS simulation
 	}
 
 	// Verify game is still running
@@ -62,8 +59,8 @@ func TestCompleteGameCycle(t *testing.T) {
 
 	// Run a few more updates to ensure everything still works
 	for i := 0; i < 5; i++ {
+		fake.Advance(time.Millisecond * 16)
 		game.update()
-		time.Sleep(time.Millisecond * 16)
 	}
 
 	t.Log("Complete game cycle test passed successfully")
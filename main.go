@@ -1,21 +1,76 @@
 package main
 
 import (
+	"cli-dino-game/src/autoplay"
 	"cli-dino-game/src/background"
 	"cli-dino-game/src/engine"
 	"cli-dino-game/src/entities"
 	"cli-dino-game/src/input"
 	"cli-dino-game/src/render"
+	"cli-dino-game/src/score"
+	"cli-dino-game/src/settings"
 	"cli-dino-game/src/spawner"
+	"errors"
 	"flag"
 	"fmt"
+	"io"
 	"log"
+	"math"
 	"os"
 	"os/signal"
 	"syscall"
 	"time"
 )
 
+// Main menu items
+const (
+	menuStart       = "Start"
+	menuLeaderboard = "Leaderboard"
+	menuSettings    = "Settings"
+	menuHelp        = "Help"
+	menuQuit        = "Quit"
+)
+
+// leaderboardSeeds is how many seed bests the leaderboard lists
+const leaderboardSeeds = 10
+
+// dinoHitboxInset trims the dinosaur's hitbox on each side at sprite scale 1,
+// so the sprite's empty corners don't cause collisions
+const dinoHitboxInset = 0.5
+
+// Settings screen items, in display order
+const (
+	settingUnicode = iota
+	settingSound
+	settingDifficulty
+	settingBack
+)
+
+// summaryDuration is how long the run summary stays up before returning to the menu
+const summaryDuration = 8 * time.Second
+
+// Holding Up or Down in a menu moves again after menuRepeatDelay, then every menuRepeatInterval
+const (
+	menuRepeatDelay    = 300 * time.Millisecond
+	menuRepeatInterval = 100 * time.Millisecond
+)
+
+// Attract mode: after attractDelay idle on the menu a demo plays itself, and
+// after attractDuration it goes back to the menu
+const (
+	attractDelay    = 15 * time.Second
+	attractDuration = 30 * time.Second
+)
+
+// toastDuration is how long each toast stays on screen once it is shown
+const toastDuration = 2 * time.Second
+
+// practiceInterval is how often the chosen obstacle comes round in practice mode
+const practiceInterval = 2 * time.Second
+
+// obstaclePoolSize is how many obstacles are allocated up front for the spawner to reuse
+const obstaclePoolSize = 10
+
 // Game represents the main game application
 type Game struct {
 	engine       *engine.GameEngine
@@ -24,14 +79,79 @@ type Game struct {
 	dinosaur     *entities.Dinosaur
 	spawner      *spawner.ObstacleSpawner
 	background   *background.BackgroundManager
+	ground       engine.GroundModel
 	config       *engine.Config
+	menu         *render.Menu
+	settingsMenu *render.Menu
+	leaderboard  []render.LeaderboardEntry
+
+	// Collapses rapid jump presses into a single jump
+	jumpDebouncer *input.Debouncer
+
+	// Holding a menu key scrolls through items; holding jump jumps once
+	menuRepeat *input.KeyRepeat
+	jumpRepeat *input.KeyRepeat
+
+	// Debug overlay
+	debug bool
+
+	// Whether collision boxes are outlined over the sprites
+	showHitboxes bool
+
+	// Frames drawn on the game over screen, for its animations
+	gameOverFrames int
+
+	// How the last run compared to the best for its seed, when the seed is fixed
+	seedComparison string
+
+	// When the run summary was opened, so it can return to the menu on its own
+	summaryStart time.Time
+
+	// Attract mode: once the menu has been idle for attractDelay the pilot
+	// plays a demo run that started at attractStart
+	pilot        *autoplay.Pilot
+	attractStart time.Time
+
+	// Whether the game is paused because the terminal is too small
+	screenTooSmall bool
+
+	// Whether the first-launch tutorial is shown over the splash screen
+	showTutorial bool
+
+	// Whether a run starts as soon as the game opens, skipping the splash and menu
+	startImmediately bool
+
+	// Receives a JSON summary of each finished run when set
+	runLog io.Writer
+
+	// Whether finished runs leave the saved high score and seed bests alone, for replays
+	noSave bool
+
+	// File each finished run's key presses are written to when set, for -replay
+	recordPath string
+	recording  []input.ReplayEvent
+	runFrames  int // Frames updated since the run started
+
+	// Two players taking turns at runs when set, with the winner shown at the end
+	hotseat   *engine.PlayerSession
+	matchSeed int64 // Seed every turn of the hotseat match plays on
 
 	// Game loop control
 	running bool
 	ticker  *time.Ticker
 
+	// Skips drawing frames when the loop falls behind, so updates and input keep up
+	frameSkip *engine.FrameSkipper
+
+	// Draws at renderFPS rather than every update for slow terminals (0 draws every update)
+	renderFPS      int
+	renderThrottle *engine.RenderThrottle
+
 	// Graceful shutdown
 	shutdownChan chan os.Signal
+
+	// Suspend requests (SIGTSTP) that hand the terminal back to the shell
+	suspendChan chan os.Signal
 }
 
 // NewGame creates a new game instance
@@ -50,49 +170,110 @@ func NewGame() (*Game, error) {
 
 	// Update config with actual terminal size
 	termWidth, termHeight := renderer.GetSize()
+	if err := engine.CheckScreenSize(termWidth, termHeight); err != nil {
+		renderer.Close()
+		return nil, err
+	}
 	config.ScreenWidth = termWidth
 	config.ScreenHeight = termHeight
 
+	// Guess Unicode support from the environment; explicit flags override this
+	config.UseUnicode = render.DetectUnicodeSupport(os.Getenv)
+
+	// Apply preferences saved from earlier launches; explicit flags override these.
+	// Only a first launch shows the tutorial, so players who saved anything before
+	// the tutorial existed skip it.
+	showTutorial := false
+	defaults := settings.Prefs{Settings: settings.FromConfig(config), TutorialSeen: !settings.IsFirstRun()}
+	if prefs, err := settings.LoadPrefs(defaults); err == nil {
+		prefs.ApplyTo(config)
+		showTutorial = !prefs.TutorialSeen
+	}
+
+	// DINO_* environment variables override preferences; explicit flags override these
+	if err := engine.ApplyEnvOverrides(config); err != nil {
+		renderer.Close()
+		return nil, err
+	}
+
+	game := newGame(config, renderer)
+	game.showTutorial = showTutorial
+
+	// Setup graceful shutdown
+	game.shutdownChan = make(chan os.Signal, 1)
+	signal.Notify(game.shutdownChan, os.Interrupt, syscall.SIGTERM)
+
+	// Restore the terminal before being stopped by job control
+	game.suspendChan = make(chan os.Signal, 1)
+	notifySuspend(game.suspendChan)
+
+	return game, nil
+}
+
+// newGame builds the game's entities and systems for config, drawing with
+// renderer. The game doesn't run until Run starts it.
+func newGame(config *engine.Config, renderer *render.Renderer) *Game {
 	// Create game engine
 	gameEngine := engine.NewGameEngine(config)
 
-	// Create input handler
-	inputHandler := input.NewInputHandler()
+	// The ground line runs along the bottom row, under the dinosaur and obstacles
+	ground := engine.NewGroundModel(config.ScreenHeight, entities.DinosaurHeight(1))
 
 	// Create dinosaur
-	groundLevel := float64(config.ScreenHeight - 5) // Leave space for dinosaur sprite
-	dinosaur := entities.NewDinosaur(groundLevel)
+	dinosaur := entities.NewDinosaur(ground.DinoGroundY())
 
-	// Calculate the actual ground line position (where obstacles should sit)
-	actualGroundY := groundLevel + dinosaur.Height
+	// Trim the hitbox so the sprite's empty corners don't cause collisions
+	dinosaur.SetHitboxInset(dinoHitboxInset, dinoHitboxInset)
 
 	// Create obstacle spawner
-	obstacleSpawner := spawner.NewObstacleSpawner(config, float64(config.ScreenWidth), actualGroundY)
+	obstacleSpawner := spawner.NewObstacleSpawner(config, float64(config.ScreenWidth), ground.ObstacleGroundY())
+	obstacleSpawner.Prewarm(obstaclePoolSize)
 
 	// Create background manager
-	backgroundManager := background.NewBackgroundManager(float64(config.ScreenWidth), float64(config.ScreenHeight), actualGroundY)
+	backgroundManager := background.NewBackgroundManager(float64(config.ScreenWidth), float64(config.ScreenHeight), ground.ObstacleGroundY())
 
-	// Setup graceful shutdown
-	shutdownChan := make(chan os.Signal, 1)
-	signal.Notify(shutdownChan, os.Interrupt, syscall.SIGTERM)
+	// Thin background detail when frames run over budget
+	renderer.SetQualityCallback(backgroundManager.SetDensity)
 
 	game := &Game{
 		engine:       gameEngine,
 		renderer:     renderer,
-		inputHandler: inputHandler,
+		inputHandler: input.NewInputHandler(),
 		dinosaur:     dinosaur,
 		spawner:      obstacleSpawner,
 		background:   backgroundManager,
+		ground:       ground,
 		config:       config,
+		menu:         render.NewMenu(menuStart, menuLeaderboard, menuSettings, menuHelp, menuQuit),
+		settingsMenu: render.NewMenu(),
 		running:      false,
-		shutdownChan: shutdownChan,
+
+		jumpDebouncer: input.NewDebouncer(config.JumpDebounce),
+		pilot:         autoplay.NewPilot(config),
+		menuRepeat:    input.NewKeyRepeat(menuRepeatDelay, menuRepeatInterval),
+		jumpRepeat:    input.NewNoRepeat(),
 	}
 
-	return game, nil
+	// Spawning and animation stop along with the run while it's paused
+	game.useRunClock()
+
+	gameEngine.SetStateChangeCallback(game.onStateChange)
+
+	// Announce distance milestones as the run reaches them
+	gameEngine.GetScore().SetMilestoneCallback(func(milestone float64) {
+		renderer.EnqueueToast(fmt.Sprintf("%.0fm!", milestone), toastDuration)
+	})
+
+	return game
 }
 
 // Run starts the main game loop
 func (g *Game) Run() error {
+	// A crash in the game loop or the input goroutine restores the terminal
+	// before the panic is reported
+	defer g.recoverPanic()
+	g.inputHandler.SetPanicCleanup(g.restoreTerminal)
+
 	// Termbox is already initialized by the renderer
 	defer g.renderer.Close()
 
@@ -107,18 +288,42 @@ func (g *Game) Run() error {
 	g.ticker = time.NewTicker(frameDuration)
 	defer g.ticker.Stop()
 
-	// Initialize game state
+	// Rendering slower than a frame triggers adaptive quality
+	g.renderer.SetFrameBudget(frameDuration)
+
+	// Running behind schedule skips drawing a frame or two to catch up
+	g.frameSkip = engine.NewFrameSkipper(frameDuration)
+
+	// Slow terminals draw less often than the game updates
+	var renderInterval time.Duration
+	if g.renderFPS > 0 {
+		renderInterval = time.Second / time.Duration(g.renderFPS)
+	}
+	g.renderThrottle = engine.NewRenderThrottle(renderInterval)
+
 	g.running = true
-	g.engine.SetState(engine.StateMenu)
+	g.enterInitialState()
 
 	// Main game loop
 	for g.running {
 		select {
 		case <-g.ticker.C:
-			// Update game state
-			g.update()
-			// Render frame
-			g.render()
+			// Pause with a message while the terminal is too small to play in
+			if !g.checkScreenSize() {
+				continue
+			}
+
+			frameStart := time.Now()
+
+			// Update game state, one frame at a time while stepping
+			if g.engine.ShouldUpdate() {
+				g.update()
+			}
+			// Render frame, unless catching up after slow frames or throttled for a slow terminal
+			if g.shouldRender(frameDuration) {
+				g.render()
+			}
+			g.frameSkip.RecordFrame(time.Since(frameStart))
 
 		case inputEvent := <-g.inputHandler.GetInputChannel():
 			// Handle input
@@ -128,21 +333,70 @@ func (g *Game) Run() error {
 			// Graceful shutdown
 			g.shutdown()
 			return nil
+
+		case <-g.suspendChan:
+			g.suspend()
 		}
 	}
 
 	return nil
 }
 
+// shouldRender reports whether to draw the frame just updated. Frames skipped to
+// catch up are ruled out first, so they don't use up the render throttle's interval.
+func (g *Game) shouldRender(frameDuration time.Duration) bool {
+	return g.frameSkip.ShouldRender() && g.renderThrottle.ShouldFlush(frameDuration)
+}
+
+// enterInitialState shows the splash screen, which waits for a first key before
+// the menu, or starts a run straight away when asked to
+func (g *Game) enterInitialState() {
+	if g.startImmediately {
+		g.startGame()
+		return
+	}
+	g.engine.SetState(engine.StateSplash)
+}
+
+// checkScreenSize picks up terminal resizes and reports whether the game fits.
+// While it doesn't, the resize message is shown instead of the game.
+func (g *Game) checkScreenSize() bool {
+	g.renderer.UpdateSize()
+	width, height := g.renderer.GetSize()
+
+	if engine.CheckScreenSize(width, height) != nil {
+		g.renderer.DrawResizeMessage(engine.MinScreenWidth, engine.MinScreenHeight)
+		g.renderer.Flush()
+		// The run stands still until the terminal is big enough again
+		if !g.screenTooSmall {
+			g.screenTooSmall = true
+			g.engine.HoldRun()
+		}
+		return false
+	}
+
+	if g.screenTooSmall {
+		g.screenTooSmall = false
+		g.engine.ReleaseRun()
+	}
+	return true
+}
+
 // update handles all game logic updates
 func (g *Game) update() {
+	// Score distance follows the current obstacle speed
+	g.engine.SetWorldSpeed(g.spawner.GetEffectiveObstacleSpeed())
+
 	// Update game engine timing
 	g.engine.Update()
 	deltaTime := g.engine.GetDeltaTime()
 
 	switch g.engine.GetState() {
 	case engine.StatePlaying:
-		// Update dinosaur
+		g.runFrames++
+
+		// Update dinosaur, its legs keeping pace with the scrolling world
+		g.dinosaur.SetRunSpeed(g.runSpeed())
 		g.dinosaur.Update(deltaTime, g.config)
 
 		// Update obstacle spawner
@@ -157,8 +411,68 @@ func (g *Game) update() {
 	case engine.StateGameOver:
 		// Game over state - no updates needed
 
+	case engine.StateSummary:
+		if g.engine.GetClock().Now().Sub(g.summaryStart) >= summaryDuration {
+			g.engine.TransitionTo(engine.StateMenu)
+		}
+
+	case engine.StateAttract:
+		g.updateAttract(deltaTime)
+
 	case engine.StateMenu:
-		// Menu state - minimal updates
+		// Play the demo once the menu has been left alone for a while
+		if g.engine.GetIdleTime() >= attractDelay {
+			g.startAttract()
+		}
+	}
+}
+
+// useRunClock drives the dinosaur and obstacles from the engine's run clock
+func (g *Game) useRunClock() {
+	g.dinosaur.SetClock(g.engine.GetRunClock())
+	g.spawner.SetClock(g.engine.GetRunClock())
+}
+
+// runSpeed returns how fast the world is scrolling relative to the configured obstacle speed
+func (g *Game) runSpeed() float64 {
+	return g.spawner.GetEffectiveObstacleSpeed() / g.config.GetObstacleSpeed()
+}
+
+// startAttract starts a fresh demo run played by the pilot
+func (g *Game) startAttract() {
+	if g.engine.GetState() != engine.StateAttract && !g.engine.TransitionTo(engine.StateAttract) {
+		return
+	}
+	g.spawner.Reset()
+	g.background.Reset()
+	g.dinosaur.Revive()
+	g.attractStart = g.engine.GetClock().Now()
+}
+
+// updateAttract advances the demo run, restarting it when the pilot crashes
+func (g *Game) updateAttract(deltaTime float64) {
+	if g.engine.GetClock().Now().Sub(g.attractStart) >= attractDuration {
+		g.engine.TransitionTo(engine.StateMenu)
+		return
+	}
+
+	if g.pilot.ShouldJump(g.dinosaur, g.spawner.GetObstacles()) {
+		g.dinosaur.Jump(g.config)
+	}
+	g.dinosaur.SetRunSpeed(g.runSpeed())
+	g.dinosaur.Update(deltaTime, g.config)
+	g.spawner.Update(deltaTime)
+	g.background.Update(deltaTime)
+
+	// The demo scores nothing, so only a hit matters
+	obstacles := g.spawner.GetObstacles()
+	collidables := make([]engine.Scorable, len(obstacles))
+	for i, obstacle := range obstacles {
+		collidables[i] = obstacle
+	}
+	dino := g.dinosaur.GetBounds()
+	if hit, _ := g.engine.ProcessCollisions(dino, collidables, dino.X); hit {
+		g.startAttract()
 	}
 }
 
@@ -167,32 +481,96 @@ func (g *Game) render() {
 	// Clear screen buffer
 	g.renderer.Clear()
 
-	switch g.engine.GetState() {
+	g.renderScreen(g.engine.GetState())
+
+	// Flush buffer to screen
+	g.renderer.Flush()
+}
+
+// renderScreen draws the screen for the given state
+func (g *Game) renderScreen(state engine.GameState) {
+	switch state {
 	case engine.StateMenu:
 		g.renderMenu()
 
 	case engine.StatePlaying:
 		g.renderGame()
 
+	case engine.StateAttract:
+		g.renderGame()
+		g.renderer.DrawAttractBanner()
+
 	case engine.StateGameOver:
 		g.renderGameOver()
+
+	case engine.StateSettings:
+		g.renderer.DrawSettingsScreen(g.settingsMenu)
+
+	case engine.StateSplash:
+		g.renderer.DrawSplashScreen()
+		if g.showTutorial {
+			g.renderer.DrawTutorial()
+		}
+
+	case engine.StateSummary:
+		g.renderSummary()
+
+	case engine.StateHelp:
+		g.renderHelp()
+
+	case engine.StateLeaderboard:
+		g.renderer.DrawLeaderboard(g.leaderboard)
+
+	case engine.StateConfirmQuit:
+		// Ask over the screen the prompt was opened from
+		g.renderScreen(g.engine.GetPreviousState())
+		g.renderer.DrawQuitConfirm()
 	}
+}
 
-	// Flush buffer to screen
-	g.renderer.Flush()
+// renderHelp lists the key bindings, then every obstacle type and how to get past it
+func (g *Game) renderHelp() {
+	var controls []render.HelpControl
+	for _, binding := range input.DefaultBindings() {
+		controls = append(controls, render.HelpControl{Keys: binding.KeyNames(), Action: binding.Action})
+	}
+
+	var entries []render.HelpEntry
+	for _, info := range entities.AllObstacleTypes() {
+		entries = append(entries, render.HelpEntry{
+			Name:     info.Name,
+			Width:    info.W,
+			Height:   info.H,
+			Jumpable: info.Jumpable,
+			Duckable: info.Duckable,
+		})
+	}
+	g.renderer.DrawHelp(controls, entries)
 }
 
 // renderMenu renders the main menu
 func (g *Game) renderMenu() {
-	// Use the new start screen renderer
-	g.renderer.DrawStartScreen()
+	// Draw the title screen with the navigable menu
+	g.renderer.DrawMenu(g.menu)
+	g.renderer.DrawSeed(g.engine.GetSeed())
+}
+
+// renderSummary renders the score breakdown for the run that just ended
+func (g *Game) renderSummary() {
+	runScore := g.engine.GetScore()
+	g.renderer.DrawRunSummary(
+		runScore.GetScoreBreakdown(),
+		runScore.GetDistance(),
+		runScore.GetObstaclesPassed(),
+		runScore.GetGameDuration(),
+	)
 }
 
 // renderGame renders the main gameplay
 func (g *Game) renderGame() {
 	// Render ground line
 	width, _ := g.renderer.GetSize()
-	groundY := int(g.dinosaur.GroundLevel) + int(g.dinosaur.Height)
+	groundY := g.ground.LineY()
 	groundChar := '-'
 	if g.config.UseUnicode {
 		groundChar = '▔'
@@ -210,61 +588,122 @@ func (g *Game) renderGame() {
 	// Render obstacles
 	g.renderObstacles()
 
+	// Warn about an incoming obstacle while the dinosaur is on the ground
+	g.renderObstacleWarning()
+
+	if g.showHitboxes {
+		g.renderHitboxes()
+	}
+
 	// Render UI
 	g.renderUI()
 }
 
-// renderDinosaur renders the dinosaur sprite
-func (g *Game) renderDinosaur() {
-	art := g.dinosaur.GetASCIIArtWithConfig(g.config.UseUnicode)
-	x := int(g.dinosaur.X)
-	y := int(g.dinosaur.Y)
+// renderObstacleWarning flags the nearest incoming obstacle above the dinosaur
+func (g *Game) renderObstacleWarning() {
+	if !g.dinosaur.IsOnGround() || g.dinosaur.IsDead {
+		return
+	}
 
-	for i, line := range art {
-		g.renderer.DrawString(x, y+i, line)
+	dinoRight := g.dinosaur.X + g.dinosaur.Width
+	obstacle := g.spawner.GetNearestObstacleAhead(dinoRight)
+	if obstacle == nil {
+		return
 	}
+
+	// Draw the warning just above the dinosaur's head
+	x := int(dinoRight)
+	y := int(g.dinosaur.Y) - 1
+	g.renderer.DrawObstacleWarning(x, y, obstacle.TimeToReach(dinoRight), g.config.WarningThreshold)
+}
+
+// renderDinosaur renders the dinosaur sprite
+func (g *Game) renderDinosaur() {
+	art := g.spriteArt(g.dinosaur.GetASCIIArtWithConfig(g.config.UseUnicode))
+	g.renderer.DrawSprite(int(g.dinosaur.X), int(g.dinosaur.Y), art, g.config.DinoColor)
 }
 
 // renderObstacles renders all active obstacles
 func (g *Game) renderObstacles() {
+	// Obstacles shift through the color ramp as they speed up
+	rampColor := ""
+	if len(g.config.ObstacleColorRamp) > 0 {
+		rampColor = render.DifficultyColor(g.config.ObstacleColorRamp, g.difficultyLevel())
+	}
+
 	obstacles := g.spawner.GetObstacles()
 	for _, obstacle := range obstacles {
 		if obstacle.IsActive() {
-			art := obstacle.GetASCIIArtWithConfig(g.config.UseUnicode)
-			x := int(obstacle.X)
-			y := int(obstacle.Y)
+			art := g.spriteArt(obstacle.GetASCIIArtWithConfig(g.config.UseUnicode))
 
-			for i, line := range art {
-				g.renderer.DrawString(x, y+i, line)
+			color := g.config.CactusColor
+			switch obstacle.GetType() {
+			case entities.BirdLow, entities.BirdMid, entities.BirdHigh:
+				color = g.config.BirdColor
+			}
+			if rampColor != "" {
+				color = rampColor
 			}
+
+			g.renderer.DrawSprite(int(obstacle.X), int(obstacle.Y), art, color)
 		}
 	}
 }
 
+// difficultyLevel returns how far the obstacle speed has climbed toward its cap,
+// from 0 at the start of a run to 1 at full speed
+func (g *Game) difficultyLevel() float64 {
+	maxSpeed := g.spawner.GetMaxSpeedMultiplier()
+	if maxSpeed <= 1 {
+		return 0
+	}
+	return (g.spawner.GetSpeedMultiplier() - 1) / (maxSpeed - 1)
+}
+
+// spriteArt scales a sprite and, in high contrast mode, swaps it for its solid silhouette
+func (g *Game) spriteArt(art []string) []string {
+	art = render.ScaleSprite(art, g.config.GetSpriteScale())
+	if g.config.HighContrast {
+		block := '#'
+		if g.config.UseUnicode {
+			block = '█'
+		}
+		art = render.HighContrastSprite(art, block)
+	}
+	return art
+}
+
 // renderBackground renders background elements (continuous hills and clouds)
 func (g *Game) renderBackground() {
-	// Render continuous hills
-	g.renderContinuousHills()
-	
-	// Render clouds
-	elements := g.background.GetElements()
-	for _, element := range elements {
-		if element.Type == background.Cloud {
-			sprite := element.GetSprite(g.config.UseUnicode)
-			x := int(element.X)
-			y := int(element.Y)
-
-			for i, line := range sprite {
-				g.renderer.DrawStringWithColor(x, y+i, line, "ash")
-			}
+	// Draw deepest first so nearer layers cover farther ones, with the continuous
+	// hills slotted in at hill depth
+	hillsDrawn := false
+	for _, element := range g.background.GetElementsByDepth() {
+		if !hillsDrawn && element.Depth <= background.Hill.Depth() {
+			g.renderContinuousHills()
+			hillsDrawn = true
 		}
+		g.renderBackgroundElement(element)
+	}
+	if !hillsDrawn {
+		g.renderContinuousHills()
 	}
 }
 
+// renderBackgroundElement draws a single cloud, hill, or mountain sprite
+func (g *Game) renderBackgroundElement(element *background.BackgroundElement) {
+	color := "dark"
+	if element.Type == background.Cloud {
+		color = "ash"
+	}
+
+	g.renderer.DrawSprite(int(element.X), int(element.Y), element.GetSprite(g.config.UseUnicode), color)
+}
+
 // renderContinuousHills renders the continuous scrolling hills
 func (g *Game) renderContinuousHills() {
 	width, _ := g.renderer.GetSize()
-	groundY := int(g.dinosaur.GroundLevel) + int(g.dinosaur.Height)
+	groundY := g.ground.LineY()
 	
 	// Create hill profile for the current screen
 	hillProfile := make([]int, width)
@@ -339,81 +778,543 @@ func (g *Game) renderContinuousHills() {
 	}
 }
 
+// renderHitboxes outlines the collision boxes of the dinosaur and every active obstacle
+func (g *Game) renderHitboxes() {
+	g.drawBounds(g.dinosaur.GetBounds())
+	for _, obstacle := range g.spawner.GetObstacles() {
+		if obstacle.IsActive() {
+			g.drawBounds(obstacle.GetBounds())
+		}
+	}
+}
+
+// drawBounds outlines every cell a collision rectangle touches
+func (g *Game) drawBounds(bounds engine.Rectangle) {
+	left := int(math.Floor(bounds.X))
+	top := int(math.Floor(bounds.Y))
+	right := int(math.Ceil(bounds.X + bounds.Width))
+	bottom := int(math.Ceil(bounds.Y + bounds.Height))
+	g.renderer.DrawHitbox(left, top, right-left, bottom-top)
+}
+
 // renderUI renders the game UI (score, etc.)
 func (g *Game) renderUI() {
 	// Use the new score display renderer
 	g.renderer.DrawScore(g.engine.GetCurrentScore(), g.engine.GetHighScore())
 
+	// Show the countdown in time attack mode
+	if g.config.GameMode == engine.ModeTimeAttack {
+		g.renderer.DrawTimeRemaining(g.engine.GetTimeRemaining())
+	}
+
+	if g.engine.IsGodMode() {
+		g.renderer.DrawGodMode()
+	}
+
+	// Remind the players whose turn it is
+	if g.hotseat != nil && g.engine.GetState() == engine.StatePlaying {
+		g.renderer.DrawPlayerTurn(g.hotseat.GetCurrentPlayer())
+	}
+
 	// Draw control instructions at the bottom
 	g.renderer.DrawControlInstructions()
+
+	g.renderer.DrawToasts()
+
+	if g.debug {
+		g.renderDebugOverlay()
+	}
+}
+
+// renderDebugOverlay renders diagnostic information for debugging
+func (g *Game) renderDebugOverlay() {
+	lines := []string{
+		fmt.Sprintf("Dropped input: %d", g.inputHandler.DroppedEventCount()),
+	}
+	lines = append(lines, "Next spawn: "+render.ProgressBar(g.spawner.GetSpawnProgress(), 10))
+	difficulty := g.spawner.GetDifficultyState()
+	lines = append(lines, fmt.Sprintf("Spawn %.2f/s  Speed %.2fx  Gap %.0f  Birds %.0f%%",
+		difficulty.SpawnRate, difficulty.SpeedMultiplier, difficulty.MinGap, difficulty.BirdWeight*100))
+	if g.spawner.IsFrozen() {
+		lines = append(lines, "Obstacles frozen (F)")
+	}
+	if g.engine.IsStepping() {
+		lines = append(lines, "Paused: S steps one frame (P resumes)")
+	}
+	g.renderer.DrawDebugInfo(lines)
 }
 
 // renderGameOver renders the game over screen
 func (g *Game) renderGameOver() {
+	// Show the dinosaur getting knocked over before the game over screen
+	if g.dinosaur.IsDeathAnimating() {
+		g.renderGame()
+		return
+	}
+
+	// Hotseat matches show the scores so far instead
+	if g.hotseat != nil {
+		g.renderHotseat()
+		return
+	}
+
 	// Use the new game over screen renderer
 	g.renderer.DrawGameOverScreen(
 		g.engine.GetCurrentScore(),
 		g.engine.GetHighScore(),
 		g.engine.IsNewHighScore(),
+		g.gameOverFrames,
 	)
+	g.gameOverFrames++
+	g.renderer.DrawSeed(g.engine.GetSeed())
+	if reason := g.unrankedReason(); reason != "" {
+		g.renderer.DrawUnranked(reason)
+	} else if g.seedComparison != "" {
+		g.renderer.DrawSeedComparison(g.seedComparison)
+	}
+}
+
+// renderHotseat shows the scores between hotseat turns, or the winner once everyone has played
+func (g *Game) renderHotseat() {
+	if !g.hotseat.IsComplete() {
+		g.renderer.DrawTurnOver(g.hotseat.GetScores(), g.hotseat.GetCurrentPlayer())
+		return
+	}
+	winner, tie := g.hotseat.GetWinner()
+	g.renderer.DrawHotseatResults(g.hotseat.GetScores(), winner, tie)
 }
 
 // handleInput processes input events
 func (g *Game) handleInput(event input.InputEvent) {
-	switch event.Key {
-	case input.KeyCtrlC, input.KeyQ:
-		g.shutdown()
+	// Any key keeps idle screens from timing out
+	g.engine.RecordInput()
 
-	case input.KeySpace, input.KeyUp:
-		switch g.engine.GetState() {
-		case engine.StateMenu:
-			g.startGame()
-		case engine.StatePlaying:
-			g.dinosaur.Jump(g.config)
+	// Quit keys work in every state
+	if event.Key == input.KeyCtrlC || event.Key == input.KeyQ {
+		g.requestQuit()
+		return
+	}
+
+	// The terminal is in raw mode, so Ctrl+Z arrives as a key rather than a signal
+	if event.Key == input.KeyCtrlZ {
+		g.suspend()
+		return
+	}
+
+	switch g.engine.GetState() {
+	case engine.StateMenu:
+		if g.allowMenuKey(event) {
+			g.handleMenuInput(event.Key)
 		}
 
-	case input.KeyR:
-		if g.engine.GetState() == engine.StateGameOver {
+	case engine.StateAttract:
+		// Any key ends the demo and starts a real game
+		g.startGame()
+
+	case engine.StatePlaying:
+		g.recordKey(event.Key)
+		switch event.Key {
+		case input.KeySpace, input.KeyUp:
+			// A held jump key jumps once rather than auto-repeating
+			if g.jumpRepeat.Allow(event) && g.jumpDebouncer.Allow(event.Time) {
+				g.dinosaur.Jump(g.config)
+			}
+		case input.KeyEscape:
+			// Abandon the run and go back to the menu
+			g.engine.TransitionTo(engine.StateMenu)
+		case input.KeyB:
+			g.showHitboxes = !g.showHitboxes
+		case input.KeyF:
+			// Hold obstacles still to inspect hitbox alignment
+			if g.debug {
+				g.spawner.SetFrozen(!g.spawner.IsFrozen())
+			}
+		case input.KeyP:
+			// Pause for single-frame stepping
+			if g.debug {
+				g.engine.SetStepping(!g.engine.IsStepping())
+			}
+		case input.KeyS:
+			if g.debug {
+				g.engine.StepOnce()
+			}
+		}
+
+	case engine.StateGameOver:
+		if g.hotseat != nil {
+			g.handleHotseatInput(event.Key)
+			return
+		}
+		switch event.Key {
+		case input.KeyR:
 			g.restartGame()
+		case input.KeyEnter:
+			g.openSummary()
+		case input.KeyEscape:
+			g.engine.TransitionTo(engine.StateMenu)
 		}
+
+	case engine.StateSummary:
+		// Any key skips the rest of the summary
+		g.engine.TransitionTo(engine.StateMenu)
+
+	case engine.StateHelp, engine.StateLeaderboard:
+		// Any key closes the help screen and the leaderboard
+		g.engine.TransitionTo(engine.StateMenu)
+
+	case engine.StateConfirmQuit:
+		switch event.Key {
+		case input.KeyY:
+			g.shutdown()
+		case input.KeyN, input.KeyEscape:
+			g.engine.TransitionTo(g.engine.GetPreviousState())
+		}
+
+	case engine.StateSettings:
+		if g.allowMenuKey(event) {
+			g.handleSettingsInput(event.Key)
+		}
+
+	case engine.StateSplash:
+		// Any key continues to the menu, and the tutorial isn't shown again
+		if g.showTutorial {
+			g.showTutorial = false
+			settings.MarkTutorialSeen()
+		}
+		g.engine.TransitionTo(engine.StateMenu)
+	}
+}
+
+// handleHotseatInput starts the next player's run between hotseat turns, and
+// goes back to the menu once everyone has played
+func (g *Game) handleHotseatInput(key input.Key) {
+	// A jump pressed as the run ended shouldn't start the next player's turn
+	if g.dinosaur.IsDeathAnimating() {
+		return
+	}
+
+	if g.hotseat.IsComplete() {
+		// Any key closes the results
+		g.engine.TransitionTo(engine.StateMenu)
+		return
+	}
+
+	switch key {
+	case input.KeySpace, input.KeyUp, input.KeyEnter:
+		g.restartGame()
+	case input.KeyEscape:
+		g.engine.TransitionTo(engine.StateMenu)
+	}
+}
+
+// allowMenuKey paces held navigation keys in menus; other keys always act
+func (g *Game) allowMenuKey(event input.InputEvent) bool {
+	if event.Key != input.KeyUp && event.Key != input.KeyDown {
+		return true
 	}
+	return g.menuRepeat.Allow(event)
 }
 
-// startGame starts a new game
+// handleMenuInput navigates the main menu and activates the selected item
+func (g *Game) handleMenuInput(key input.Key) {
+	switch key {
+	case input.KeyUp:
+		g.menu.MoveUp()
+	case input.KeyDown:
+		g.menu.MoveDown()
+	case input.KeySpace, input.KeyEnter:
+		switch g.menu.Selected() {
+		case menuStart:
+			g.startGame()
+		case menuLeaderboard:
+			g.openLeaderboard()
+		case menuSettings:
+			g.openSettings()
+		case menuHelp:
+			g.engine.TransitionTo(engine.StateHelp)
+		case menuQuit:
+			g.shutdown()
+		}
+	case input.KeyH:
+		g.engine.TransitionTo(engine.StateHelp)
+	case input.KeyEscape:
+		g.engine.TransitionTo(engine.StateConfirmQuit)
+	}
+}
+
+// openLeaderboard shows the saved high score and the best scores for fixed
+// seeds, read once as the screen opens
+func (g *Game) openLeaderboard() {
+	g.leaderboard = nil
+	if high, err := score.LoadHighScore(); err == nil && high > 0 {
+		g.leaderboard = append(g.leaderboard, render.LeaderboardEntry{Label: "Best run", Score: high})
+	}
+	if bests, err := score.LoadSeedBests(); err == nil {
+		if len(bests) > leaderboardSeeds {
+			bests = bests[:leaderboardSeeds]
+		}
+		for _, best := range bests {
+			g.leaderboard = append(g.leaderboard, render.LeaderboardEntry{
+				Label: fmt.Sprintf("Seed %d", best.Seed),
+				Score: best.Score,
+			})
+		}
+	}
+	g.engine.TransitionTo(engine.StateLeaderboard)
+}
+
+// openSettings shows the settings screen
+func (g *Game) openSettings() {
+	g.settingsMenu = render.NewMenu(g.settingsItems()...)
+	g.engine.TransitionTo(engine.StateSettings)
+}
+
+// settingsItems returns the settings screen labels for the current config
+func (g *Game) settingsItems() []string {
+	onOff := func(enabled bool) string {
+		if enabled {
+			return "On"
+		}
+		return "Off"
+	}
+
+	return []string{
+		settingUnicode:    "Unicode: " + onOff(g.config.UseUnicode),
+		settingSound:      "Sound: " + onOff(g.config.SoundEnabled),
+		settingDifficulty: "Difficulty: " + g.config.Difficulty.String(),
+		settingBack:       "Back",
+	}
+}
+
+// handleSettingsInput navigates the settings screen and changes the selected setting
+func (g *Game) handleSettingsInput(key input.Key) {
+	switch key {
+	case input.KeyUp:
+		g.settingsMenu.MoveUp()
+	case input.KeyDown:
+		g.settingsMenu.MoveDown()
+	case input.KeySpace, input.KeyEnter:
+		g.changeSelectedSetting()
+	case input.KeyEscape:
+		g.engine.TransitionTo(engine.StateMenu)
+	}
+}
+
+// changeSelectedSetting toggles or cycles the selected setting and saves the result
+func (g *Game) changeSelectedSetting() {
+	var changed string
+	switch g.settingsMenu.SelectedIndex() {
+	case settingUnicode:
+		g.config.UseUnicode = !g.config.UseUnicode
+		changed = settings.KeyUnicode
+	case settingSound:
+		g.config.SoundEnabled = !g.config.SoundEnabled
+		changed = settings.KeySound
+	case settingDifficulty:
+		g.config.ApplyDifficulty(g.config.Difficulty.Next())
+		g.spawner.SetSpawnRate(g.config.SpawnRate)
+		changed = settings.KeyDifficulty
+	case settingBack:
+		g.engine.TransitionTo(engine.StateMenu)
+		return
+	}
+
+	// Only the changed setting is saved, so the others keep following their
+	// defaults. Changes still apply to this session if they can't be saved.
+	settings.SaveSettings(settings.FromConfig(g.config), changed)
+	g.settingsMenu.SetItems(g.settingsItems()...)
+}
+
+// startGame starts a new game, and a new match with player 1 in hotseat mode
 func (g *Game) startGame() {
+	if g.hotseat != nil {
+		g.hotseat.Reset()
+	}
 	g.engine.Start()
+	g.matchSeed = g.engine.GetSeed()
+	g.seedRun()
 	g.spawner.Reset()
+	g.engine.GetScore().SetSaving(g.savesScores())
 	g.background.Reset()
+	g.dinosaur.Revive()
+	g.gameOverFrames = 0
+	g.renderer.ClearToasts()
+	g.startRecording()
+}
+
+// openSummary shows the run summary after a game over
+func (g *Game) openSummary() {
+	if g.engine.TransitionTo(engine.StateSummary) {
+		g.summaryStart = g.engine.GetClock().Now()
+	}
 }
 
 // restartGame restarts the game from game over state
 func (g *Game) restartGame() {
-	g.engine.Restart()
+	if g.hotseat != nil {
+		// Every player in a match faces the same course
+		g.engine.RestartOnSeed(g.matchSeed)
+	} else {
+		g.engine.Restart()
+	}
+	g.seedRun()
 	g.spawner.Reset()
+	g.engine.GetScore().SetSaving(g.savesScores())
 	g.background.Reset()
+	g.dinosaur.Revive()
+	g.gameOverFrames = 0
+	g.renderer.ClearToasts()
+	g.startRecording()
+}
+
+// setSpriteScale magnifies sprites and hitboxes. The config's physics read back
+// magnified through its getters, so jumps clear the larger obstacles with the
+// same timing while the stored values stay at scale 1.
+func (g *Game) setSpriteScale(scale int) {
+	g.config.SpriteScale = scale
+
+	// Keep the ground line at the bottom of the screen with the taller dinosaur
+	g.dinosaur.SetScale(scale)
+	inset := dinoHitboxInset * float64(g.config.GetSpriteScale())
+	g.dinosaur.SetHitboxInset(inset, inset)
+	g.ground = engine.NewGroundModel(g.config.ScreenHeight, g.dinosaur.Height)
+	g.dinosaur.GroundLevel = g.ground.DinoGroundY()
+	g.dinosaur.Y = g.dinosaur.GroundLevel
+	g.spawner.SetGroundLevel(g.ground.ObstacleGroundY())
+}
+
+// seedRun seeds the spawner and background with the engine's seed for the new run
+func (g *Game) seedRun() {
+	seed := g.engine.GetSeed()
+	g.spawner.SetSeed(seed)
+	g.background.SetSeed(seed)
 }
 
 // checkCollisions checks for collisions between dinosaur and obstacles
 func (g *Game) checkCollisions() {
-	dinosaurBounds := g.dinosaur.GetBounds()
 	obstacles := g.spawner.GetObstacles()
+	collidables := make([]engine.Scorable, len(obstacles))
+	for i, obstacle := range obstacles {
+		collidables[i] = obstacle
+	}
 
-	for _, obstacle := range obstacles {
-		if obstacle.IsActive() {
-			obstacleBounds := obstacle.GetBounds()
-			if g.engine.CheckCollision(dinosaurBounds, obstacleBounds) {
-				g.engine.TriggerGameOver()
-				return
-			}
-		}
+	// Obstacles count as passed once they are fully behind the dinosaur's hitbox
+	dino := g.dinosaur.GetBounds()
+	hit, passed := g.engine.ProcessCollisions(dino, collidables, dino.X)
+	if hit {
+		g.dinosaur.Die()
+		g.engine.TriggerGameOver()
+		return
 	}
 
 	// Award points for obstacles that have passed the dinosaur
-	for _, obstacle := range obstacles {
-		if obstacle.IsActive() && obstacle.X+obstacle.Width < g.dinosaur.X {
-			g.engine.AddObstacleBonus()
-			obstacle.Deactivate() // Prevent multiple bonuses for same obstacle
-		}
+	for _, obstacle := range passed {
+		g.engine.AddObstacleBonus()
+		obstacle.MarkScored() // Bonus is awarded exactly once per obstacle
+	}
+}
+
+// onStateChange records each run as it ends, whether by collision or the clock running out
+func (g *Game) onStateChange(from, to engine.GameState) {
+	if to != engine.StateGameOver {
+		return
+	}
+	g.logRun()
+	g.saveRecording()
+	g.compareSeedBest()
+	if g.hotseat != nil {
+		g.hotseat.RecordTurn(g.engine.GetCurrentScore())
+	}
+}
+
+// unrankedReason explains why runs don't count towards saved scores, or returns
+// "" when they do. God mode runs can't end and practice runs only meet one kind
+// of obstacle, so neither compares with a real run.
+func (g *Game) unrankedReason() string {
+	if g.engine.IsGodMode() {
+		return "God mode: score not saved"
+	}
+	if _, _, practice := g.spawner.GetPracticeMode(); practice {
+		return "Practice: score not saved"
+	}
+	return ""
+}
+
+// savesScores reports whether finished runs can update the saved high score and
+// seed bests. Replays and runs that don't count never do.
+func (g *Game) savesScores() bool {
+	return !g.noSave && g.unrankedReason() == ""
+}
+
+// compareSeedBest compares the finished run with the best saved for its seed,
+// saving it if it beats that. Only fixed seeds are compared, since they replay
+// the same obstacles, and only on runs that are saved.
+func (g *Game) compareSeedBest() {
+	g.seedComparison = ""
+	if g.config.Seed == 0 || !g.savesScores() {
+		return
+	}
+
+	seed := g.engine.GetSeed()
+	best, err := score.LoadSeedBest(seed)
+	if err != nil {
+		return
+	}
+	g.seedComparison = score.CompareSeedBest(seed, g.engine.GetCurrentScore(), best)
+	score.SaveSeedBest(seed, g.engine.GetCurrentScore())
+}
+
+// logRun appends the finished run's summary to the run log, if there is one
+func (g *Game) logRun() {
+	if g.runLog == nil {
+		return
+	}
+	engine.WriteRunResult(g.runLog, g.engine.GetRunResult())
+}
+
+// requestQuit quits, or asks first when quit confirmation is enabled
+func (g *Game) requestQuit() {
+	if g.config.ConfirmQuit && g.engine.TransitionTo(engine.StateConfirmQuit) {
+		return
+	}
+	g.shutdown()
+}
+
+// suspend restores the terminal and stops the process like Ctrl+Z, then takes
+// the terminal back when the shell resumes it. The time spent stopped doesn't
+// count towards the run or as a frame.
+func (g *Game) suspend() {
+	g.engine.HoldRun()
+	g.renderer.Suspend()
+	stopProcess()
+	g.engine.ReleaseRun()
+
+	if err := g.renderer.Resume(); err != nil {
+		// The terminal is already back in its normal mode, so the error can be printed
+		log.Printf("Failed to resume: %v", err)
+		g.shutdown()
+		return
+	}
+	if g.frameSkip != nil {
+		g.frameSkip.Reset()
+	}
+	if g.renderThrottle != nil {
+		g.renderThrottle.Reset()
+	}
+}
+
+// restoreTerminal stops reading keys and hands the terminal back to the shell,
+// so a crash report is readable and the shell isn't left garbled
+func (g *Game) restoreTerminal() {
+	g.inputHandler.Stop()
+	g.renderer.Close()
+}
+
+// recoverPanic restores the terminal before passing on a panic in the game loop
+func (g *Game) recoverPanic() {
+	if r := recover(); r != nil {
+		g.restoreTerminal()
+		panic(r)
 	}
 }
 
@@ -430,24 +1331,238 @@ func (g *Game) Cleanup() {
 	g.engine.Cleanup()
 }
 
+// prefFlags holds the command line flags that override stored preferences
+type prefFlags struct {
+	unicode    bool
+	ascii      bool
+	sound      bool
+	difficulty engine.Difficulty
+}
+
+// apply overrides config values for the flags that were set explicitly and
+// returns the settings keys of the preferences they changed
+func (f prefFlags) apply(config *engine.Config, explicitFlags map[string]bool) []string {
+	var changed []string
+
+	if f.ascii {
+		config.UseUnicode = false
+		changed = append(changed, settings.KeyUnicode)
+	} else if explicitFlags["unicode"] {
+		config.UseUnicode = f.unicode
+		changed = append(changed, settings.KeyUnicode)
+	}
+
+	if explicitFlags["sound"] {
+		config.SoundEnabled = f.sound
+		changed = append(changed, settings.KeySound)
+	}
+
+	if explicitFlags["difficulty"] {
+		config.ApplyDifficulty(f.difficulty)
+		changed = append(changed, settings.KeyDifficulty)
+	}
+
+	return changed
+}
+
 func main() {
 	// Parse command line flags
-	useUnicode := flag.Bool("unicode", true, "Use Unicode characters for rendering (default: true for better visuals)")
+	useUnicode := flag.Bool("unicode", true, "Use Unicode characters for rendering (default: autodetected from the terminal locale)")
 	asciiMode := flag.Bool("ascii", false, "Use ASCII characters instead of Unicode (for terminals with poor Unicode support)")
+	timed := flag.Int("timed", 0, "Play a time attack run lasting the given number of seconds (0 for endless)")
+	seed := flag.Int64("seed", 0, "Seed for obstacle and background generation (0 picks a random seed each run)")
+	diffRender := flag.Bool("diff-render", false, "Only redraw changed cells each frame (faster over slow connections such as SSH)")
+	scale := flag.Int("scale", 1, fmt.Sprintf("Magnify sprites for tall terminals (1-%d)", engine.MaxSpriteScale))
+	debug := flag.Bool("debug", false, "Show the debug overlay and collision boxes, and log to ~/.cli-dino-game/debug.log")
+	sound := flag.Bool("sound", true, "Play sound effects")
+	difficultyName := flag.String("difficulty", "normal", "Difficulty preset: easy, normal, or hard")
+	confirmQuit := flag.Bool("confirm-quit", false, "Ask for confirmation before Q or Ctrl+C quits")
+	highContrast := flag.Bool("high-contrast", false, "Bold text, solid borders, and blocky sprites for low-vision players")
+	logJSON := flag.String("log-json", "", "Append a JSON summary of each run to this file")
+	god := flag.Bool("god", false, "Turn off collisions so runs never end (for testing, or to watch the scenery)")
+	practiceTypeName := flag.String("practice-type", "", "Practice one obstacle: only this type spawns, at a steady pace (cactus-small, cactus-medium, cactus-large, bird-low, bird-mid, bird-high)")
+	groundJitter := flag.Int("ground-jitter", 0, fmt.Sprintf("Let cacti sit up to this many rows above or below the ground line, for variety (0-%d)", spawner.MaxGroundJitter))
+	renderFPS := flag.Int("render-fps", 0, "Draw at most this many frames a second, below the update rate, for slow terminals such as SSH (0 draws every update)")
+	colorRamp := flag.Bool("color-ramp", false, "Tint obstacles from green through yellow to red as the game speeds up, instead of their cactus and bird colors")
+	start := flag.Bool("start", false, "Skip the splash screen and menu and start a run straight away (for speedruns and scripts)")
+	hotseat := flag.Bool("hotseat", false, "Two players take turns at a run each on the same keyboard; the higher score wins")
+	replayPath := flag.String("replay", "", "Play the run recorded in this file without a terminal, using -seed and -difficulty, and print its score")
+	verify := flag.Int("verify", 0, "With -replay, fail unless the replayed run scores exactly this")
+	recordPath := flag.String("record", "", "Write the key presses of each finished run to this file, replacing the last, for -replay")
 	flag.Parse()
 
+	difficulty, err := engine.ParseDifficulty(*difficultyName)
+	if err != nil {
+		log.Fatalf("Invalid -difficulty: %v", err)
+	}
+	if *renderFPS < 0 {
+		log.Fatalf("Invalid -render-fps: must not be negative")
+	}
+	var practiceType entities.ObstacleType
+	if *practiceTypeName != "" {
+		practiceType, err = entities.ParseObstacleType(*practiceTypeName)
+		if err != nil {
+			log.Fatalf("Invalid -practice-type: %v", err)
+		}
+	}
+	if *groundJitter < 0 || *groundJitter > spawner.MaxGroundJitter {
+		log.Fatalf("Invalid -ground-jitter: must be between 0 and %d", spawner.MaxGroundJitter)
+	}
+	if *scale < 1 || *scale > engine.MaxSpriteScale {
+		log.Fatalf("Invalid -scale: must be between 1 and %d", engine.MaxSpriteScale)
+	}
+
+	// Open the run log before the terminal is taken over, so errors can be printed
+	var runLog *os.File
+	if *logJSON != "" {
+		runLog, err = engine.OpenRunLog(*logJSON)
+		if err != nil {
+			log.Fatalf("Invalid -log-json: %v", err)
+		}
+	}
+
+	// Check the recording file can be written before the terminal is taken over
+	if *recordPath != "" {
+		file, err := os.Create(*recordPath)
+		if err != nil {
+			log.Fatalf("Invalid -record: %v", err)
+		}
+		file.Close()
+	}
+
+	// Track which flags were set explicitly so they can override detected defaults
+	explicitFlags := make(map[string]bool)
+	flag.Visit(func(f *flag.Flag) {
+		explicitFlags[f.Name] = true
+	})
+
+	// Replays run without a terminal, from default settings so saved preferences
+	// can't change the outcome
+	if explicitFlags["verify"] && *replayPath == "" {
+		log.Fatalf("-verify needs a run to check with -replay")
+	}
+	if *replayPath != "" {
+		if *seed == 0 {
+			log.Fatalf("-replay needs the seed the run was recorded with (-seed)")
+		}
+		config := engine.NewDefaultConfig()
+		config.ApplyDifficulty(difficulty)
+		if !explicitFlags["verify"] {
+			events, err := loadReplay(*replayPath)
+			if err != nil {
+				log.Fatalf("Invalid -replay: %v", err)
+			}
+			score, err := runReplay(config, *seed, events)
+			if err != nil {
+				log.Fatalf("Replay failed: %v", err)
+			}
+			fmt.Printf("Replay scored %d\n", score)
+			return
+		}
+		if err := verifyReplay(config, *replayPath, *seed, *verify); err != nil {
+			log.Fatalf("Replay verification failed: %v", err)
+		}
+		fmt.Printf("Replay verified: scored %d\n", *verify)
+		return
+	}
+
 	// Create game instance
 	game, err := NewGame()
+	if errors.Is(err, engine.ErrScreenTooSmall) {
+		fmt.Printf("CLI Dino Game doesn't fit: %v\n", err)
+		os.Exit(1)
+	}
 	if err != nil {
 		log.Fatalf("Failed to create game: %v", err)
 	}
 	defer game.Cleanup()
 
-	// Set Unicode preference
-	if *asciiMode {
-		game.config.UseUnicode = false
-	} else {
-		game.config.UseUnicode = *useUnicode
+	// Explicit flags override stored preferences and are remembered for the next launch
+	flags := prefFlags{
+		unicode:    *useUnicode,
+		ascii:      *asciiMode,
+		sound:      *sound,
+		difficulty: difficulty,
+	}
+	if changed := flags.apply(game.config, explicitFlags); len(changed) > 0 {
+		game.spawner.SetSpawnRate(game.config.SpawnRate)
+		settings.SaveSettings(settings.FromConfig(game.config), changed...)
+	}
+
+	game.debug = *debug
+	game.showHitboxes = *debug
+	game.config.ConfirmQuit = *confirmQuit
+
+	// Easier to see for low-vision players
+	if *highContrast {
+		game.config.HighContrast = true
+		game.renderer.SetHighContrast(true)
+	}
+
+	// Obstacles pass straight through the dinosaur
+	game.engine.SetGodMode(*god)
+
+	// Telegraph rising speed in the obstacles' colors
+	if *colorRamp {
+		game.config.ObstacleColorRamp = append([]string(nil), engine.DefaultObstacleColorRamp...)
+	}
+
+	// Straight into a run
+	game.startImmediately = *start
+
+	// Local two-player match
+	if *hotseat {
+		game.hotseat = engine.NewPlayerSession(2)
+	}
+
+	// Drill a single obstacle
+	if *practiceTypeName != "" {
+		game.spawner.SetPracticeMode(practiceType, practiceInterval)
+	}
+
+	// Raise and bury cacti a little
+	game.spawner.SetGroundJitter(*groundJitter)
+
+	// Bigger sprites for tall terminals
+	if *scale > 1 {
+		game.setSpriteScale(*scale)
+	}
+
+	// Debug logs go to a file so they can't scramble the game screen
+	if *debug {
+		if logFile, err := engine.OpenDebugLog(); err == nil {
+			defer logFile.Close()
+			engine.SetDebugOutput(logFile)
+			game.engine.EnableCollisionDebug(true)
+		}
+	}
+
+	// Run summaries go to a file so they can't scramble the game screen
+	if runLog != nil {
+		defer runLog.Close()
+		game.runLog = runLog
+	}
+
+	// Keep each run's key presses for replaying
+	game.recordPath = *recordPath
+
+	// Only send changed cells to the terminal
+	if *diffRender {
+		game.renderer.SetDiffRendering(true)
+	}
+
+	// Draw less often than the game updates
+	game.renderFPS = *renderFPS
+
+	// Use a fixed seed so runs can be shared
+	if *seed != 0 {
+		game.engine.SetSeed(*seed)
+	}
+
+	// Set game mode
+	if *timed > 0 {
+		game.config.GameMode = engine.ModeTimeAttack
+		game.config.TimeLimit = time.Duration(*timed) * time.Second
 	}
 
 	// Run the game
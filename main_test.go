@@ -1,10 +1,23 @@
 package main
 
 import (
+	"bytes"
+	"cli-dino-game/src/autoplay"
+	"cli-dino-game/src/clock"
 	"cli-dino-game/src/engine"
 	"cli-dino-game/src/entities"
 	"cli-dino-game/src/input"
+	"cli-dino-game/src/render"
+	"cli-dino-game/src/score"
+	"cli-dino-game/src/settings"
 	"cli-dino-game/src/spawner"
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
 	"testing"
 	"time"
 )
@@ -15,7 +28,10 @@ type TestGame struct {
 	dinosaur *entities.Dinosaur
 	spawner  *spawner.ObstacleSpawner
 	config   *engine.Config
+	menu     *render.Menu
+	pilot    *autoplay.Pilot
 	running  bool
+	debug    bool
 }
 
 // NewTestGame creates a game instance suitable for testing
@@ -25,84 +41,199 @@ func NewTestGame() *TestGame {
 	config.ScreenHeight = 20
 
 	gameEngine := engine.NewGameEngine(config)
-	groundLevel := float64(config.ScreenHeight - 7)
-	dinosaur := entities.NewDinosaur(groundLevel)
-	obstacleSpawner := spawner.NewObstacleSpawner(config, float64(config.ScreenWidth), groundLevel)
+	ground := engine.NewGroundModel(config.ScreenHeight, entities.DinosaurHeight(1))
+	dinosaur := entities.NewDinosaur(ground.DinoGroundY())
+	obstacleSpawner := spawner.NewObstacleSpawner(config, float64(config.ScreenWidth), ground.ObstacleGroundY())
+	dinosaur.SetClock(gameEngine.GetRunClock())
+	obstacleSpawner.SetClock(gameEngine.GetRunClock())
 
 	return &TestGame{
 		engine:   gameEngine,
 		dinosaur: dinosaur,
 		spawner:  obstacleSpawner,
 		config:   config,
+		menu:     render.NewMenu(menuStart, menuLeaderboard, menuSettings, menuHelp, menuQuit),
+		pilot:    autoplay.NewPilot(config),
 		running:  false,
 	}
 }
 
+// useFakeClock drives the game from a fake clock so tests can advance time without sleeping
+func (g *TestGame) useFakeClock() *clock.FakeClock {
+	fake := clock.NewFakeClock(time.Now())
+	g.engine.SetClock(fake)
+	g.spawner.SetClock(g.engine.GetRunClock())
+	g.dinosaur.SetClock(g.engine.GetRunClock())
+	return fake
+}
+
+// tick simulates one pass of the game loop, which only updates while not paused for stepping
+func (g *TestGame) tick() {
+	if g.engine.ShouldUpdate() {
+		g.update()
+	}
+}
+
 // update simulates the game update cycle
 func (g *TestGame) update() {
+	g.engine.SetWorldSpeed(g.spawner.GetEffectiveObstacleSpeed())
 	g.engine.Update()
 	deltaTime := g.engine.GetDeltaTime()
 
-	if g.engine.GetState() == engine.StatePlaying {
+	switch g.engine.GetState() {
+	case engine.StatePlaying:
+		g.dinosaur.SetRunSpeed(g.spawner.GetEffectiveObstacleSpeed() / g.config.GetObstacleSpeed())
 		g.dinosaur.Update(deltaTime, g.config)
 		g.spawner.Update(deltaTime)
 		g.checkCollisions()
+	case engine.StateAttract:
+		if g.pilot.ShouldJump(g.dinosaur, g.spawner.GetObstacles()) {
+			g.dinosaur.Jump(g.config)
+		}
+		g.dinosaur.Update(deltaTime, g.config)
+		g.spawner.Update(deltaTime)
+
+		obstacles := g.spawner.GetObstacles()
+		collidables := make([]engine.Scorable, len(obstacles))
+		for i, obstacle := range obstacles {
+			collidables[i] = obstacle
+		}
+		dino := g.dinosaur.GetBounds()
+		if hit, _ := g.engine.ProcessCollisions(dino, collidables, dino.X); hit {
+			g.startAttract()
+		}
 	}
 }
 
+// startAttract simulates starting a demo run from the menu
+func (g *TestGame) startAttract() {
+	if g.engine.GetState() != engine.StateAttract && !g.engine.TransitionTo(engine.StateAttract) {
+		return
+	}
+	g.spawner.Reset()
+	g.dinosaur.Revive()
+}
+
 // checkCollisions simulates collision detection
 func (g *TestGame) checkCollisions() {
-	dinosaurBounds := g.dinosaur.GetBounds()
 	obstacles := g.spawner.GetObstacles()
+	collidables := make([]engine.Scorable, len(obstacles))
+	for i, obstacle := range obstacles {
+		collidables[i] = obstacle
+	}
 
-	for _, obstacle := range obstacles {
-		if obstacle.IsActive() {
-			obstacleBounds := obstacle.GetBounds()
-			if g.engine.CheckCollision(dinosaurBounds, obstacleBounds) {
-				g.engine.TriggerGameOver()
-				return
-			}
-		}
+	// Obstacles count as passed once they are fully behind the dinosaur's hitbox
+	dino := g.dinosaur.GetBounds()
+	hit, passed := g.engine.ProcessCollisions(dino, collidables, dino.X)
+	if hit {
+		g.dinosaur.Die()
+		g.engine.TriggerGameOver()
+		return
 	}
 
 	// Award points for obstacles that have passed the dinosaur
-	for _, obstacle := range obstacles {
-		if obstacle.IsActive() && obstacle.X+obstacle.Width < g.dinosaur.X {
-			g.engine.AddObstacleBonus()
-			obstacle.Deactivate()
-		}
+	for _, obstacle := range passed {
+		g.engine.AddObstacleBonus()
+		obstacle.MarkScored() // Bonus is awarded exactly once per obstacle
 	}
 }
 
 // handleInput simulates input handling
 func (g *TestGame) handleInput(key input.Key) {
-	switch key {
-	case input.KeySpace, input.KeyUp:
-		switch g.engine.GetState() {
-		case engine.StateMenu:
-			g.startGame()
-		case engine.StatePlaying:
+	g.engine.RecordInput()
+
+	if key == input.KeyQ || key == input.KeyCtrlC {
+		if g.config.ConfirmQuit && g.engine.TransitionTo(engine.StateConfirmQuit) {
+			return
+		}
+		g.running = false
+		return
+	}
+
+	switch g.engine.GetState() {
+	case engine.StateMenu:
+		g.handleMenuInput(key)
+	case engine.StatePlaying:
+		switch key {
+		case input.KeySpace, input.KeyUp:
 			g.dinosaur.Jump(g.config)
+		case input.KeyEscape:
+			g.engine.TransitionTo(engine.StateMenu)
+		case input.KeyP:
+			if g.debug {
+				g.engine.SetStepping(!g.engine.IsStepping())
+			}
+		case input.KeyS:
+			if g.debug {
+				g.engine.StepOnce()
+			}
 		}
-	case input.KeyR:
-		if g.engine.GetState() == engine.StateGameOver {
+	case engine.StateGameOver:
+		switch key {
+		case input.KeyR:
 			g.restartGame()
+		case input.KeyEnter:
+			g.engine.TransitionTo(engine.StateSummary)
+		case input.KeyEscape:
+			g.engine.TransitionTo(engine.StateMenu)
+		}
+	case engine.StateSummary, engine.StateHelp, engine.StateLeaderboard:
+		g.engine.TransitionTo(engine.StateMenu)
+	case engine.StateSplash:
+		g.engine.TransitionTo(engine.StateMenu)
+	case engine.StateAttract:
+		g.startGame()
+	case engine.StateConfirmQuit:
+		switch key {
+		case input.KeyY:
+			g.running = false
+		case input.KeyN, input.KeyEscape:
+			g.engine.TransitionTo(g.engine.GetPreviousState())
 		}
-	case input.KeyQ:
-		g.running = false
+	}
+}
+
+// handleMenuInput simulates main menu navigation
+func (g *TestGame) handleMenuInput(key input.Key) {
+	switch key {
+	case input.KeyUp:
+		g.menu.MoveUp()
+	case input.KeyDown:
+		g.menu.MoveDown()
+	case input.KeySpace, input.KeyEnter:
+		switch g.menu.Selected() {
+		case menuStart:
+			g.startGame()
+		case menuLeaderboard:
+			g.engine.TransitionTo(engine.StateLeaderboard)
+		case menuSettings:
+			g.engine.TransitionTo(engine.StateSettings)
+		case menuHelp:
+			g.engine.TransitionTo(engine.StateHelp)
+		case menuQuit:
+			g.running = false
+		}
+	case input.KeyH:
+		g.engine.TransitionTo(engine.StateHelp)
+	case input.KeyEscape:
+		g.engine.TransitionTo(engine.StateConfirmQuit)
 	}
 }
 
 // startGame starts a new game
 func (g *TestGame) startGame() {
 	g.engine.Start()
+	g.spawner.SetSeed(g.engine.GetSeed())
 	g.spawner.Reset()
+	g.dinosaur.Revive()
 }
 
 // restartGame restarts the game
 func (g *TestGame) restartGame() {
 	g.engine.Restart()
+	g.spawner.SetSeed(g.engine.GetSeed())
 	g.spawner.Reset()
+	g.dinosaur.Revive()
 }
 
 // TestGameCreation tests that a new game can be created successfully
@@ -190,151 +321,1527 @@ func TestInputHandling(t *testing.T) {
 	}
 }
 
-// TestGameUpdate tests the game update cycle
-func TestGameUpdate(t *testing.T) {
+// TestMenuNavigation tests navigating and selecting main menu items
+func TestMenuNavigation(t *testing.T) {
 	game := NewTestGame()
+	game.running = true
 
-	// Set game to playing state
-	game.engine.SetState(engine.StatePlaying)
+	// Up wraps around from Start to Quit
+	game.handleInput(input.KeyUp)
+	if game.menu.Selected() != menuQuit {
+		t.Errorf("Expected Quit to be selected after wrapping up, got %s", game.menu.Selected())
+	}
+	if game.engine.GetState() != engine.StateMenu {
+		t.Error("Navigating the menu should not start the game")
+	}
 
-	// Record initial state
-	initialScore := game.engine.GetCurrentScore()
+	// Down wraps back to Start, and Enter starts the game
+	game.handleInput(input.KeyDown)
+	game.handleInput(input.KeyEnter)
+	if game.engine.GetState() != engine.StatePlaying {
+		t.Errorf("Selecting Start should begin the game, got state %v", game.engine.GetState())
+	}
 
-	// Simulate several update cycles with longer delays to accumulate score
-	for i := 0; i < 20; i++ {
-		game.update()
-		time.Sleep(time.Millisecond * 50) // Longer delay to accumulate score
+	// Selecting Leaderboard opens the leaderboard, and any key closes it
+	game = NewTestGame()
+	game.handleInput(input.KeyDown)
+	game.handleInput(input.KeyEnter)
+	if game.engine.GetState() != engine.StateLeaderboard {
+		t.Errorf("Selecting Leaderboard should open the leaderboard, got state %v", game.engine.GetState())
+	}
+	game.handleInput(input.KeySpace)
+	if game.engine.GetState() != engine.StateMenu {
+		t.Errorf("Any key should close the leaderboard, got state %v", game.engine.GetState())
 	}
 
-	// Verify game state has been updated
-	if game.engine.GetDeltaTime() <= 0 {
-		t.Error("Delta time should be positive after updates")
+	// Selecting Settings opens the settings screen
+	game = NewTestGame()
+	game.handleInput(input.KeyDown)
+	game.handleInput(input.KeyDown)
+	game.handleInput(input.KeyEnter)
+	if game.engine.GetState() != engine.StateSettings {
+		t.Errorf("Selecting Settings should open the settings screen, got state %v", game.engine.GetState())
 	}
 
-	// Score should increase over time in playing state (allow for some tolerance)
-	currentScore := game.engine.GetCurrentScore()
-	if currentScore <= initialScore {
-		// Score might not increase immediately, so let's just verify the system is working
-		t.Logf("Score did not increase from %d to %d, but this may be normal for short test duration", initialScore, currentScore)
+	// Selecting Help opens the obstacle list, and any key closes it
+	game = NewTestGame()
+	game.handleInput(input.KeyDown)
+	game.handleInput(input.KeyDown)
+	game.handleInput(input.KeyDown)
+	game.handleInput(input.KeyEnter)
+	if game.engine.GetState() != engine.StateHelp {
+		t.Errorf("Selecting Help should open the help screen, got state %v", game.engine.GetState())
+	}
+	game.handleInput(input.KeySpace)
+	if game.engine.GetState() != engine.StateMenu {
+		t.Errorf("Any key should close the help screen, got state %v", game.engine.GetState())
 	}
 
-	// Verify the spawner is functioning
-	if game.spawner.GetGameTime() <= 0 {
-		t.Error("Spawner game time should increase")
+	// Selecting Quit stops the game
+	game = NewTestGame()
+	game.running = true
+	game.handleInput(input.KeyUp)
+	game.handleInput(input.KeyEnter)
+	if game.running {
+		t.Error("Selecting Quit should stop the game")
 	}
 }
 
-// TestCollisionDetection tests collision detection integration
-func TestCollisionDetection(t *testing.T) {
+// TestSplashWaitsForFirstKey tests that the splash screen holds until a key arrives
+func TestSplashWaitsForFirstKey(t *testing.T) {
 	game := NewTestGame()
+	game.running = true
+	game.engine.SetState(engine.StateSplash)
 
-	// Set game to playing state
-	game.engine.SetState(engine.StatePlaying)
-
-	// Test collision detection with overlapping rectangles
-	dinosaurBounds := game.dinosaur.GetBounds()
-	testRect := engine.Rectangle{
-		X:      dinosaurBounds.X,
-		Y:      dinosaurBounds.Y,
-		Width:  dinosaurBounds.Width,
-		Height: dinosaurBounds.Height,
+	// Without input the game stays on the splash screen
+	for i := 0; i < 5; i++ {
+		game.update()
 	}
-
-	// Test collision detection
-	if !game.engine.CheckCollision(dinosaurBounds, testRect) {
-		t.Error("Collision should be detected for overlapping rectangles")
+	if game.engine.GetState() != engine.StateSplash {
+		t.Errorf("Expected to stay on the splash screen without input, got state %v", game.engine.GetState())
 	}
 
-	// Test that collision check doesn't crash
-	game.checkCollisions()
-	t.Log("Collision check completed without errors")
+	// Any key, even an unmapped one, continues to the menu
+	game.handleInput(input.KeyUnknown)
+	if game.engine.GetState() != engine.StateMenu {
+		t.Errorf("Expected the first key to open the menu, got state %v", game.engine.GetState())
+	}
+	if !game.running {
+		t.Error("Leaving the splash screen should not stop the game")
+	}
 }
 
-// TestGameLoopTiming tests that the game loop maintains consistent timing
-func TestGameLoopTiming(t *testing.T) {
+// TestEscapeBacksOut tests Escape returning to the menu and asking before quitting
+func TestEscapeBacksOut(t *testing.T) {
 	game := NewTestGame()
+	game.running = true
 
-	// Test that frame duration is calculated correctly
-	expectedFrameDuration := time.Second / time.Duration(game.config.TargetFPS)
+	// Escape abandons a run in progress
+	game.startGame()
+	game.handleInput(input.KeyEscape)
+	if game.engine.GetState() != engine.StateMenu {
+		t.Errorf("Expected Escape during play to return to the menu, got state %v", game.engine.GetState())
+	}
 
-	// Verify the calculation
-	if expectedFrameDuration <= 0 {
-		t.Error("Frame duration should be positive")
+	// Escape leaves the game over screen
+	game.startGame()
+	game.engine.TriggerGameOver()
+	game.handleInput(input.KeyEscape)
+	if game.engine.GetState() != engine.StateMenu {
+		t.Errorf("Expected Escape after game over to return to the menu, got state %v", game.engine.GetState())
 	}
 
-	// For 30 FPS, frame duration should be approximately 33.33ms
-	if game.config.TargetFPS == 30 {
-		expectedMs := time.Millisecond * 33
-		if expectedFrameDuration < expectedMs || expectedFrameDuration > expectedMs+time.Millisecond {
-			t.Errorf("Expected frame duration around %v, got %v", expectedMs, expectedFrameDuration)
-		}
+	// From the menu Escape asks for confirmation, and N cancels
+	game.handleInput(input.KeyEscape)
+	if game.engine.GetState() != engine.StateConfirmQuit {
+		t.Fatalf("Expected Escape in the menu to ask to quit, got state %v", game.engine.GetState())
+	}
+	game.handleInput(input.KeySpace)
+	if game.engine.GetState() != engine.StateConfirmQuit || !game.running {
+		t.Error("Expected other keys to leave the quit prompt open")
+	}
+	game.handleInput(input.KeyN)
+	if game.engine.GetState() != engine.StateMenu || !game.running {
+		t.Errorf("Expected N to cancel quitting, got state %v", game.engine.GetState())
+	}
+
+	// Escape also cancels, and Y quits
+	game.handleInput(input.KeyEscape)
+	game.handleInput(input.KeyEscape)
+	if game.engine.GetState() != engine.StateMenu {
+		t.Errorf("Expected a second Escape to cancel quitting, got state %v", game.engine.GetState())
+	}
+	game.handleInput(input.KeyEscape)
+	game.handleInput(input.KeyY)
+	if game.running {
+		t.Error("Expected Y to confirm quitting")
 	}
 }
 
-// TestGameIntegration tests complete game cycles
-func TestGameIntegration(t *testing.T) {
+// TestQuitConfirmation tests that enabled quit confirmation only exits on Y
+func TestQuitConfirmation(t *testing.T) {
 	game := NewTestGame()
+	game.running = true
+	game.config.ConfirmQuit = true
+	game.startGame()
 
-	// Test complete game flow: menu -> playing -> game over -> restart
+	// Q mid-run asks instead of quitting, and N resumes the run
+	game.handleInput(input.KeyQ)
+	if game.engine.GetState() != engine.StateConfirmQuit || !game.running {
+		t.Fatalf("Expected Q to ask before quitting, got state %v", game.engine.GetState())
+	}
+	game.handleInput(input.KeyN)
+	if game.engine.GetState() != engine.StatePlaying || !game.running {
+		t.Errorf("Expected N to resume the run, got state %v", game.engine.GetState())
+	}
 
-	// Start from menu
-	if game.engine.GetState() != engine.StateMenu {
-		t.Error("Game should start in menu state")
+	// Ctrl+C asks too, and Y confirms
+	game.handleInput(input.KeyCtrlC)
+	if game.engine.GetState() != engine.StateConfirmQuit {
+		t.Fatalf("Expected Ctrl+C to ask before quitting, got state %v", game.engine.GetState())
+	}
+	game.handleInput(input.KeyY)
+	if game.running {
+		t.Error("Expected Y to confirm quitting")
 	}
 
-	// Start game
-	game.handleInput(input.KeySpace)
+	// Pressing Q again at the prompt quits as well
+	game = NewTestGame()
+	game.running = true
+	game.config.ConfirmQuit = true
+	game.handleInput(input.KeyQ)
+	game.handleInput(input.KeyQ)
+	if game.running {
+		t.Error("Expected a second Q to quit")
+	}
+
+	// Without confirmation Q quits straight away
+	game = NewTestGame()
+	game.running = true
+	game.handleInput(input.KeyQ)
+	if game.running {
+		t.Error("Expected Q to quit immediately by default")
+	}
+}
+
+// TestQuitPromptHoldsRun tests that a real game spawns nothing and keeps the same
+// running frame while the quit prompt is open over a run
+func TestQuitPromptHoldsRun(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	config := engine.NewDefaultConfig()
+	config.ConfirmQuit = true
+	game, fake := newHeadlessGame(config)
+	game.startGame()
+	frame := game.dinosaur.GetAnimationFrame()
+
+	game.handleInput(input.InputEvent{Key: input.KeyQ, Time: fake.Now()})
+	fake.Advance(10*time.Second + game.dinosaur.GetAnimationSpeed()/2)
+	game.handleInput(input.InputEvent{Key: input.KeyN, Time: fake.Now()})
+	fake.Advance(time.Second / time.Duration(config.TargetFPS))
+	game.update()
+
 	if game.engine.GetState() != engine.StatePlaying {
-		t.Error("Game should transition to playing state")
+		t.Fatalf("Expected N to resume the run, got state %v", game.engine.GetState())
+	}
+	if len(game.spawner.GetObstacles()) != 0 {
+		t.Error("Expected no spawn for the time spent at the prompt")
 	}
+	if got := game.dinosaur.GetAnimationFrame(); got != frame {
+		t.Errorf("Expected the running frame to hold at the prompt, got %d instead of %d", got, frame)
+	}
+}
 
-	// Simulate gameplay
-	for i := 0; i < 5; i++ {
+// TestQuickDoubleTaps tests that two quick taps of a menu key move twice and that
+// a jump pressed again soon after the last one still jumps
+func TestQuickDoubleTaps(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	config := engine.NewDefaultConfig()
+	config.JumpVelocity = 10 // A short hop, landing well within a second
+	game, fake := newHeadlessGame(config)
+	game.engine.SetState(engine.StateMenu)
+
+	game.handleInput(input.InputEvent{Key: input.KeyDown, Time: fake.Now()})
+	game.handleInput(input.InputEvent{Key: input.KeyDown, Time: fake.Now().Add(200 * time.Millisecond)})
+	if game.menu.SelectedIndex() != 2 {
+		t.Errorf("Expected two taps of Down to move twice, got item %d", game.menu.SelectedIndex())
+	}
+
+	game.startGame()
+	game.handleInput(input.InputEvent{Key: input.KeySpace, Time: fake.Now()})
+	for frames := 0; !game.dinosaur.IsOnGround() && frames < 100; frames++ {
+		fake.Advance(time.Second / time.Duration(config.TargetFPS))
 		game.update()
-		time.Sleep(time.Millisecond * 10)
 	}
+	fake.Advance(time.Second / time.Duration(config.TargetFPS))
+	game.handleInput(input.InputEvent{Key: input.KeySpace, Time: fake.Now()})
+	if game.dinosaur.IsOnGround() {
+		t.Error("Expected a second jump soon after landing to jump again")
+	}
+}
+
+// TestSuspendRestoresTerminal tests that Ctrl+Z hands the terminal back while
+// stopped and takes it again on resume, without the stop counting as a frame
+func TestSuspendRestoresTerminal(t *testing.T) {
+	backend := render.NewBufferBackend(80, 24)
+	game := &Game{
+		engine:   engine.NewGameEngine(engine.NewDefaultConfig()),
+		renderer: render.NewRendererWithBackend(backend),
+		running:  true,
+	}
+	fake := clock.NewFakeClock(time.Now())
+	game.engine.SetClock(fake)
 
-	// Trigger game over
+	stops := 0
+	defer func(original func()) { stopProcess = original }(stopProcess)
+	stopProcess = func() {
+		stops++
+		if !backend.IsClosed() {
+			t.Error("Expected the terminal to be restored before stopping")
+		}
+		fake.Advance(time.Minute) // Time spent in the background
+	}
+
+	game.handleInput(input.InputEvent{Key: input.KeyCtrlZ, Time: time.Now()})
+	if stops != 1 {
+		t.Fatalf("Expected Ctrl+Z to stop the process once, got %d", stops)
+	}
+	if backend.IsClosed() || game.renderer.IsSuspended() {
+		t.Error("Expected the terminal to be taken back after resuming")
+	}
+	if !game.running {
+		t.Error("Expected the game to keep running after resuming")
+	}
+
+	game.engine.Update()
+	if game.engine.GetDeltaTime() != 0 {
+		t.Errorf("Expected no frame time for the stop, got %f", game.engine.GetDeltaTime())
+	}
+}
+
+// TestSuspendHoldsRun tests that time spent stopped with Ctrl+Z doesn't move the
+// run, its obstacles, or the dinosaur's animation on
+func TestSuspendHoldsRun(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	config := engine.NewDefaultConfig()
+	game, fake := newHeadlessGame(config)
+	game.startGame()
+	start := game.engine.GetRunClock().Now()
+	frame := game.dinosaur.GetAnimationFrame()
+
+	defer func(original func()) { stopProcess = original }(stopProcess)
+	stopProcess = func() {
+		fake.Advance(10*time.Second + game.dinosaur.GetAnimationSpeed()/2)
+	}
+	game.handleInput(input.InputEvent{Key: input.KeyCtrlZ, Time: fake.Now()})
+	fake.Advance(time.Second / time.Duration(config.TargetFPS))
+	game.update()
+
+	if got := game.engine.GetRunClock().Now().Sub(start); got > time.Second {
+		t.Errorf("Expected the run to stand still while stopped, but %v passed", got)
+	}
+	if len(game.spawner.GetObstacles()) != 0 {
+		t.Error("Expected no spawn for the time spent stopped")
+	}
+	if got := game.dinosaur.GetAnimationFrame(); got != frame {
+		t.Errorf("Expected the running frame to hold while stopped, got %d instead of %d", got, frame)
+	}
+}
+
+// TestIdleTimeoutReturnsToMenu tests that a game over screen left alone goes back to the menu
+func TestIdleTimeoutReturnsToMenu(t *testing.T) {
+	game := NewTestGame()
+	fake := game.useFakeClock()
+	game.config.IdleTimeout = 10 * time.Second
+	game.startGame()
 	game.engine.TriggerGameOver()
+
+	// A key press partway through keeps the screen up
+	fake.Advance(8 * time.Second)
+	game.handleInput(input.KeyUnknown)
+	fake.Advance(8 * time.Second)
+	game.update()
 	if game.engine.GetState() != engine.StateGameOver {
-		t.Error("Game should transition to game over state")
+		t.Fatalf("Expected the game over screen to stay after a key press, got %v", game.engine.GetState())
 	}
 
-	// Restart game
-	game.handleInput(input.KeyR)
-	if game.engine.GetState() != engine.StatePlaying {
-		t.Error("Game should restart to playing state")
+	// Then nothing at all
+	for i := 0; i < 30; i++ {
+		fake.Advance(100 * time.Millisecond)
+		game.update()
 	}
-
-	// Verify score was reset
-	if game.engine.GetCurrentScore() != 0 {
-		t.Error("Score should be reset on restart")
+	if game.engine.GetState() != engine.StateMenu {
+		t.Errorf("Expected the idle game over screen to return to the menu, got %v", game.engine.GetState())
 	}
 }
 
-// BenchmarkGameUpdate benchmarks the game update performance
-func BenchmarkGameUpdate(b *testing.B) {
+// TestAttractModeKeyStartsRealGame tests that any key during the demo starts a fresh real game
+func TestAttractModeKeyStartsRealGame(t *testing.T) {
 	game := NewTestGame()
-	game.engine.SetState(engine.StatePlaying)
+	fake := game.useFakeClock()
+	game.engine.SetState(engine.StateMenu)
 
-	b.ResetTimer()
-	for i := 0; i < b.N; i++ {
+	game.startAttract()
+	if game.engine.GetState() != engine.StateAttract {
+		t.Fatalf("Expected the demo to start from the menu, got %v", game.engine.GetState())
+	}
+
+	// Let the demo play for a while
+	for i := 0; i < 90; i++ {
+		fake.Advance(time.Second / 15)
 		game.update()
 	}
+	if game.engine.GetState() != engine.StateAttract {
+		t.Fatalf("Expected the demo to keep running, got %v", game.engine.GetState())
+	}
+	if game.spawner.GetGameTime() == 0 {
+		t.Fatal("Expected the demo to have advanced the world")
+	}
+	if game.engine.GetCurrentScore() != 0 {
+		t.Errorf("Expected the demo not to score, got %d", game.engine.GetCurrentScore())
+	}
+
+	game.handleInput(input.KeyUnknown)
+	if game.engine.GetState() != engine.StatePlaying {
+		t.Fatalf("Expected a key to start a real game, got %v", game.engine.GetState())
+	}
+	if game.spawner.GetGameTime() != 0 || game.spawner.GetActiveObstacleCount() != 0 {
+		t.Errorf("Expected the real game to start with a fresh world, got game time %f and %d obstacles",
+			game.spawner.GetGameTime(), game.spawner.GetActiveObstacleCount())
+	}
+	if game.dinosaur.IsDead || !game.dinosaur.IsOnGround() {
+		t.Error("Expected the dinosaur to start the real game alive on the ground")
+	}
+	if game.engine.GetCurrentScore() != 0 {
+		t.Errorf("Expected the real game to start at score 0, got %d", game.engine.GetCurrentScore())
+	}
 }
 
-// BenchmarkCollisionDetection benchmarks collision detection performance
-func BenchmarkCollisionDetection(b *testing.B) {
+// TestDebugFrameStepping tests that in debug mode a paused run advances exactly one frame per step
+func TestDebugFrameStepping(t *testing.T) {
 	game := NewTestGame()
-	game.engine.SetState(engine.StatePlaying)
+	game.debug = true
+	fake := game.useFakeClock()
+	game.startGame()
 
-	// Create some obstacles for testing
-	for i := 0; i < 5; i++ {
-		game.spawner.Update(0.1) // Force spawn some obstacles
+	game.handleInput(input.KeyP)
+	if !game.engine.IsStepping() {
+		t.Fatal("Expected P to pause for stepping in debug mode")
 	}
 
-	b.ResetTimer()
-	for i := 0; i < b.N; i++ {
-		game.checkCollisions()
+	// Nothing advances while paused, however much time passes
+	fake.Advance(time.Second)
+	game.tick()
+	if game.spawner.GetGameTime() != 0 {
+		t.Errorf("Expected no game time while paused, got %f", game.spawner.GetGameTime())
+	}
+
+	// A step advances exactly one frame's delta, and only once
+	frame := 1.0 / float64(game.config.TargetFPS)
+	game.handleInput(input.KeyS)
+	fake.Advance(time.Second)
+	game.tick()
+	game.tick()
+	if game.spawner.GetGameTime() != frame {
+		t.Errorf("Expected a step to advance game time by %f, got %f", frame, game.spawner.GetGameTime())
+	}
+
+	// P resumes normal updates
+	game.handleInput(input.KeyP)
+	fake.Advance(100 * time.Millisecond)
+	game.tick()
+	if game.spawner.GetGameTime() <= frame {
+		t.Error("Expected game time to advance again after resuming")
+	}
+
+	// The step keys do nothing outside debug mode
+	game = NewTestGame()
+	game.startGame()
+	game.handleInput(input.KeyP)
+	if game.engine.IsStepping() {
+		t.Error("Expected P to be ignored without debug mode")
+	}
+}
+
+// TestSteppingHoldsSpawnsAndAnimation tests that a real game spawns nothing and
+// keeps the same running frame across a stepping pause, however long it lasts
+func TestSteppingHoldsSpawnsAndAnimation(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	config := engine.NewDefaultConfig()
+	game, fake := newHeadlessGame(config)
+	game.debug = true
+	game.startGame()
+
+	game.handleInput(input.InputEvent{Key: input.KeyP, Time: fake.Now()})
+	obstacles := len(game.spawner.GetObstacles())
+	frame := game.dinosaur.GetAnimationFrame()
+
+	// Long enough for several running frames and any spawn delay
+	fake.Advance(10*time.Second + game.dinosaur.GetAnimationSpeed()/2)
+	game.handleInput(input.InputEvent{Key: input.KeyS, Time: fake.Now()})
+	fake.Advance(time.Second / time.Duration(config.TargetFPS))
+	game.update()
+
+	if got := len(game.spawner.GetObstacles()); got != obstacles {
+		t.Errorf("Expected no spawn across the pause, got %d obstacles instead of %d", got, obstacles)
+	}
+	if got := game.dinosaur.GetAnimationFrame(); got != frame {
+		t.Errorf("Expected the running frame to hold across the pause, got %d instead of %d", got, frame)
+	}
+}
+
+// TestTooSmallScreenHoldsRun tests that the run stands still while the terminal is
+// too small for the game
+func TestTooSmallScreenHoldsRun(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	config := engine.NewDefaultConfig()
+	game, fake := newHeadlessGame(config)
+	game.startGame()
+	start := game.engine.GetRunClock().Now()
+	fitting := game.renderer
+
+	game.renderer = render.NewRendererWithBackend(render.NewBufferBackend(engine.MinScreenWidth-1, engine.MinScreenHeight))
+	if game.checkScreenSize() {
+		t.Fatal("Expected a narrow terminal not to fit the game")
+	}
+	fake.Advance(10 * time.Second)
+	game.checkScreenSize()
+
+	game.renderer = fitting
+	if !game.checkScreenSize() {
+		t.Fatal("Expected the game to fit again")
+	}
+	fake.Advance(time.Second / time.Duration(config.TargetFPS))
+	game.update()
+
+	if got := game.engine.GetRunClock().Now().Sub(start); got > time.Second {
+		t.Errorf("Expected the run to stand still while too small, but %v passed", got)
+	}
+	if len(game.spawner.GetObstacles()) != 0 {
+		t.Error("Expected no spawn for the time spent too small")
+	}
+}
+
+// TestPrefFlagsOverrideStoredPrefs tests that explicit flags win over loaded preferences
+func TestPrefFlagsOverrideStoredPrefs(t *testing.T) {
+	// Simulate preferences loaded at startup
+	config := engine.NewDefaultConfig()
+	config.UseUnicode = false
+	config.SoundEnabled = false
+	config.ApplyDifficulty(engine.DifficultyEasy)
+
+	flags := prefFlags{unicode: true, sound: true, difficulty: engine.DifficultyHard}
+
+	// Flags left at their defaults don't touch the stored preferences
+	if changed := flags.apply(config, map[string]bool{}); len(changed) != 0 {
+		t.Errorf("Expected no change without explicit flags, got %v", changed)
+	}
+	if config.UseUnicode || config.SoundEnabled || config.Difficulty != engine.DifficultyEasy {
+		t.Errorf("Stored preferences should be kept, got %s", config)
+	}
+
+	// Explicit flags take precedence
+	explicit := map[string]bool{"unicode": true, "difficulty": true}
+	changed := flags.apply(config, explicit)
+	if !reflect.DeepEqual(changed, []string{settings.KeyUnicode, settings.KeyDifficulty}) {
+		t.Errorf("Expected only the explicit flags to be saved, got %v", changed)
+	}
+	if !config.UseUnicode {
+		t.Error("Explicit -unicode should override the stored preference")
+	}
+	if config.Difficulty != engine.DifficultyHard {
+		t.Errorf("Explicit -difficulty should override the stored preference, got %s", config.Difficulty)
+	}
+	if config.SoundEnabled {
+		t.Error("Sound was not set explicitly and should keep the stored preference")
+	}
+
+	// -ascii always forces ASCII rendering
+	flags.ascii = true
+	flags.apply(config, explicit)
+	if config.UseUnicode {
+		t.Error("-ascii should force ASCII rendering")
+	}
+}
+
+// TestGameUpdate tests the game update cycle
+func TestGameUpdate(t *testing.T) {
+	game := NewTestGame()
+
+	fake := game.useFakeClock()
+
+	// Set game to playing state
+	game.engine.SetState(engine.StatePlaying)
+
+	// Record initial state
+	initialScore := game.engine.GetCurrentScore()
+
+	// Simulate a second of update cycles to accumulate score
+	for i := 0; i < 20; i++ {
+		fake.Advance(time.Millisecond * 50)
+		game.update()
+	}
+
+	// Verify game state has been updated
+	if game.engine.GetDeltaTime() <= 0 {
+		t.Error("Delta time should be positive after updates")
+	}
+
+	// Score should increase over time in playing state
+	if currentScore := game.engine.GetCurrentScore(); currentScore <= initialScore {
+		t.Errorf("Expected score to increase from %d after a second of play, got %d", initialScore, currentScore)
+	}
+
+	// Verify the spawner is functioning
+	if game.spawner.GetGameTime() <= 0 {
+		t.Error("Spawner game time should increase")
+	}
+}
+
+// TestScoreDistanceMatchesObstacleMovement tests that score distance is how far the world actually scrolled
+func TestScoreDistanceMatchesObstacleMovement(t *testing.T) {
+	game := NewTestGame()
+	fake := game.useFakeClock()
+	game.spawner.SetWarmupDuration(2 * time.Second) // Include the speed ramp at the start of a run
+	game.startGame()
+
+	// Advance the world as update does, leaving out collisions so the run can't end
+	for i := 0; i < 150; i++ {
+		fake.Advance(time.Second / 15)
+		game.engine.SetWorldSpeed(game.spawner.GetEffectiveObstacleSpeed())
+		game.engine.Update()
+		game.spawner.Update(game.engine.GetDeltaTime())
+	}
+
+	scrolled := game.spawner.GetDistance()
+	distance := game.engine.GetScore().Distance
+	if math.Abs(distance-scrolled) > scrolled*0.02 {
+		t.Errorf("Expected score distance %.1f to match the %.1f units obstacles moved", distance, scrolled)
+	}
+}
+
+// TestCollisionDetection tests collision detection integration
+func TestCollisionDetection(t *testing.T) {
+	game := NewTestGame()
+
+	// Set game to playing state
+	game.engine.SetState(engine.StatePlaying)
+
+	// Test collision detection with overlapping rectangles
+	dinosaurBounds := game.dinosaur.GetBounds()
+	testRect := engine.Rectangle{
+		X:      dinosaurBounds.X,
+		Y:      dinosaurBounds.Y,
+		Width:  dinosaurBounds.Width,
+		Height: dinosaurBounds.Height,
+	}
+
+	// Test collision detection
+	if !game.engine.CheckCollision(dinosaurBounds, testRect) {
+		t.Error("Collision should be detected for overlapping rectangles")
+	}
+
+	// Test that collision check doesn't crash
+	game.checkCollisions()
+	t.Log("Collision check completed without errors")
+}
+
+// TestCollisionKillsDinosaur tests that a collision starts the death animation and restarting revives the dinosaur
+func TestCollisionKillsDinosaur(t *testing.T) {
+	game := NewTestGame()
+	game.engine.SetState(engine.StatePlaying)
+
+	// A fresh spawner spawns immediately; move the obstacle onto the dinosaur
+	game.spawner.Update(0)
+	obstacle := game.spawner.GetObstacles()[0]
+	obstacle.X = game.dinosaur.X
+	obstacle.Y = game.dinosaur.Y
+
+	game.checkCollisions()
+	if game.engine.GetState() != engine.StateGameOver {
+		t.Fatalf("Expected game over after collision, got %v", game.engine.GetState())
+	}
+	if !game.dinosaur.IsDead || !game.dinosaur.IsDeathAnimating() {
+		t.Error("Expected the dinosaur to be dead and playing its death animation")
+	}
+
+	game.handleInput(input.KeyR)
+	if game.dinosaur.IsDead {
+		t.Error("Expected restart to revive the dinosaur")
+	}
+}
+
+// TestObstacleBonusAwardedOnce tests that passing an obstacle awards its bonus exactly once
+func TestObstacleBonusAwardedOnce(t *testing.T) {
+	game := NewTestGame()
+	game.engine.SetState(engine.StatePlaying)
+
+	// A fresh spawner spawns immediately on its first update
+	game.spawner.Update(0)
+	obstacles := game.spawner.GetObstacles()
+	if len(obstacles) != 1 {
+		t.Fatalf("Expected 1 spawned obstacle, got %d", len(obstacles))
+	}
+
+	// Move the obstacle just past the dinosaur
+	obstacle := obstacles[0]
+	obstacle.X = game.dinosaur.X - obstacle.Width - 1
+
+	// Run several frames of collision processing
+	for i := 0; i < 5; i++ {
+		game.checkCollisions()
+	}
+
+	if passed := game.engine.GetScore().GetObstaclesPassed(); passed != 1 {
+		t.Errorf("Expected obstacle bonus to be awarded once, got %d", passed)
+	}
+	if game.engine.GetCurrentScore() != game.engine.GetScore().ObstacleBonus {
+		t.Errorf("Expected score %d, got %d", game.engine.GetScore().ObstacleBonus, game.engine.GetCurrentScore())
+	}
+	if !obstacle.IsScored() {
+		t.Error("Obstacle should be marked as scored")
+	}
+}
+
+// TestGameLoopTiming tests that the game loop maintains consistent timing
+func TestGameLoopTiming(t *testing.T) {
+	game := NewTestGame()
+
+	// Test that frame duration is calculated correctly
+	expectedFrameDuration := time.Second / time.Duration(game.config.TargetFPS)
+
+	// Verify the calculation
+	if expectedFrameDuration <= 0 {
+		t.Error("Frame duration should be positive")
+	}
+
+	// For 30 FPS, frame duration should be approximately 33.33ms
+	if game.config.TargetFPS == 30 {
+		expectedMs := time.Millisecond * 33
+		if expectedFrameDuration < expectedMs || expectedFrameDuration > expectedMs+time.Millisecond {
+			t.Errorf("Expected frame duration around %v, got %v", expectedMs, expectedFrameDuration)
+		}
+	}
+}
+
+// TestGameIntegration tests complete game cycles
+func TestGameIntegration(t *testing.T) {
+	game := NewTestGame()
+
+	// Test complete game flow: menu -> playing -> game over -> restart
+
+	// Start from menu
+	if game.engine.GetState() != engine.StateMenu {
+		t.Error("Game should start in menu state")
+	}
+
+	// Start game
+	game.handleInput(input.KeySpace)
+	if game.engine.GetState() != engine.StatePlaying {
+		t.Error("Game should transition to playing state")
+	}
+
+	// Simulate gameplay
+	fake := game.useFakeClock()
+	for i := 0; i < 5; i++ {
+		fake.Advance(time.Millisecond * 10)
+		game.update()
+	}
+
+	// Trigger game over
+	game.engine.TriggerGameOver()
+	if game.engine.GetState() != engine.StateGameOver {
+		t.Error("Game should transition to game over state")
+	}
+
+	// Restart game
+	game.handleInput(input.KeyR)
+	if game.engine.GetState() != engine.StatePlaying {
+		t.Error("Game should restart to playing state")
+	}
+
+	// Verify score was reset
+	if game.engine.GetCurrentScore() != 0 {
+		t.Error("Score should be reset on restart")
+	}
+}
+
+// BenchmarkGameUpdate benchmarks the game update performance
+func BenchmarkGameUpdate(b *testing.B) {
+	game := NewTestGame()
+	game.engine.SetState(engine.StatePlaying)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		game.update()
+	}
+}
+
+// BenchmarkCollisionDetection benchmarks collision detection performance
+func BenchmarkCollisionDetection(b *testing.B) {
+	game := NewTestGame()
+	game.engine.SetState(engine.StatePlaying)
+
+	// Create some obstacles for testing
+	for i := 0; i < 5; i++ {
+		game.spawner.Update(0.1) // Force spawn some obstacles
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		game.checkCollisions()
+	}
+}
+
+// TestHelpListsBoundKeys tests that H opens the help screen and that it shows every key binding
+func TestHelpListsBoundKeys(t *testing.T) {
+	backend := render.NewBufferBackend(80, 24)
+	game := &Game{
+		engine:   engine.NewGameEngine(engine.NewDefaultConfig()),
+		renderer: render.NewRendererWithBackend(backend),
+		running:  true,
+	}
+
+	game.handleInput(input.InputEvent{Key: input.KeyH, Time: time.Now()})
+	if game.engine.GetState() != engine.StateHelp {
+		t.Fatalf("Expected H to open the help screen, got state %v", game.engine.GetState())
+	}
+
+	game.renderScreen(game.engine.GetState())
+	game.renderer.Flush()
+
+	var screen strings.Builder
+	for y := 0; y < 24; y++ {
+		screen.WriteString(backend.Line(y))
+		screen.WriteString("\n")
+	}
+	text := screen.String()
+
+	for _, binding := range input.DefaultBindings() {
+		if !strings.Contains(text, binding.KeyNames()) || !strings.Contains(text, binding.Action) {
+			t.Errorf("Expected help to list %s: %s, got:\n%s", binding.KeyNames(), binding.Action, text)
+		}
+	}
+	for _, info := range entities.AllObstacleTypes() {
+		if !strings.Contains(text, info.Name) {
+			t.Errorf("Expected help to list %s", info.Name)
+		}
+	}
+}
+
+// TestMilestoneToasts tests that reaching a distance milestone shows a toast until it expires
+func TestMilestoneToasts(t *testing.T) {
+	backend := render.NewBufferBackend(80, 24)
+	renderer := render.NewRendererWithBackend(backend)
+	fake := clock.NewFakeClock(time.Now())
+	renderer.SetClock(fake)
+
+	gameEngine := engine.NewGameEngine(engine.NewDefaultConfig())
+	gameEngine.GetScore().SetMilestoneCallback(func(milestone float64) {
+		renderer.EnqueueToast(fmt.Sprintf("%.0fm!", milestone), toastDuration)
+	})
+
+	score := gameEngine.GetScore()
+	score.SetDistanceRate(100)
+	score.Update(1.0)
+
+	renderer.DrawToasts()
+	renderer.Flush()
+	if !strings.Contains(backend.Line(2), "100m!") {
+		t.Errorf("Expected a 100m toast on screen, got %q", backend.Line(2))
+	}
+
+	// Once shown for long enough the toast is dropped
+	fake.Advance(toastDuration)
+	renderer.Clear()
+	renderer.DrawToasts()
+	renderer.Flush()
+	if strings.Contains(backend.Line(2), "100m!") {
+		t.Error("Expected the toast to expire")
+	}
+}
+
+// TestTutorialShownOnce tests that the tutorial covers the splash screen until a key
+// dismisses it, and that it is remembered as seen
+func TestTutorialShownOnce(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	backend := render.NewBufferBackend(80, 24)
+	game := &Game{
+		engine:       engine.NewGameEngine(engine.NewDefaultConfig()),
+		renderer:     render.NewRendererWithBackend(backend),
+		running:      true,
+		showTutorial: true,
+	}
+	game.engine.SetState(engine.StateSplash)
+
+	screen := func() string {
+		game.render()
+		var text strings.Builder
+		for y := 0; y < 24; y++ {
+			text.WriteString(backend.Line(y))
+		}
+		return text.String()
+	}
+
+	if !strings.Contains(screen(), "HOW TO PLAY") {
+		t.Fatal("Expected the tutorial on the first launch")
+	}
+
+	game.handleInput(input.InputEvent{Key: input.KeySpace, Time: time.Now()})
+	if game.engine.GetState() != engine.StateMenu || game.showTutorial {
+		t.Errorf("Expected a key to dismiss the tutorial and open the menu, got state %v", game.engine.GetState())
+	}
+	if prefs, _ := settings.LoadPrefs(settings.Prefs{}); !prefs.TutorialSeen {
+		t.Error("Expected the tutorial to be remembered as seen")
+	}
+
+	// Later launches skip it
+	game.showTutorial = false
+	game.engine.SetState(engine.StateSplash)
+	if strings.Contains(screen(), "HOW TO PLAY") {
+		t.Error("Expected no tutorial once seen")
+	}
+}
+
+// TestFlagsOverrideEnv tests that explicit flags take precedence over DINO_* variables
+func TestFlagsOverrideEnv(t *testing.T) {
+	t.Setenv(engine.EnvDifficulty, "easy")
+	t.Setenv(engine.EnvUnicode, "false")
+
+	config := engine.NewDefaultConfig()
+	if err := engine.ApplyEnvOverrides(config); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	flags := prefFlags{unicode: true, sound: true, difficulty: engine.DifficultyHard}
+	flags.apply(config, map[string]bool{"difficulty": true})
+	if config.Difficulty != engine.DifficultyHard {
+		t.Errorf("Expected the -difficulty flag to win, got %s", config.Difficulty)
+	}
+	if config.UseUnicode {
+		t.Error("Expected DINO_UNICODE to stand when -unicode isn't given")
+	}
+}
+
+// TestLogRun tests that a finished run is written to the run log as JSON
+func TestLogRun(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	var buf bytes.Buffer
+	game := &Game{
+		engine: engine.NewGameEngine(engine.NewDefaultConfig()),
+		runLog: &buf,
+	}
+	game.engine.SetSeed(7)
+	game.engine.SetState(engine.StatePlaying)
+	game.engine.AddObstacleBonus()
+	game.engine.TriggerGameOver()
+	game.logRun()
+
+	var result engine.RunResult
+	if err := json.Unmarshal(buf.Bytes(), &result); err != nil {
+		t.Fatalf("Expected a JSON run summary, got %q: %v", buf.String(), err)
+	}
+	if result.Seed != 7 || result.ObstaclesPassed != 1 || result.Score != game.engine.GetCurrentScore() {
+		t.Errorf("Expected the run's seed, obstacles, and score, got %+v", result)
+	}
+
+	// Without a run log nothing is written
+	game.runLog = nil
+	game.logRun()
+}
+
+// recordPilotRun records the jumps the autoplay pilot makes over the given number
+// of frames, then leaves the run, as a recorded run to replay
+func recordPilotRun(config *engine.Config, seed int64, frames int) ([]input.ReplayEvent, int) {
+	game, fake := newHeadlessGame(config)
+	game.engine.SetSeed(seed)
+	game.startGame()
+	frameDuration := time.Second / time.Duration(config.TargetFPS)
+
+	var events []input.ReplayEvent
+	for frame := 0; frame < frames && game.engine.GetState() == engine.StatePlaying; frame++ {
+		if game.pilot.ShouldJump(game.dinosaur, game.spawner.GetObstacles()) {
+			events = append(events, input.ReplayEvent{Frame: frame, Key: input.KeySpace})
+			game.handleInput(input.InputEvent{Key: input.KeySpace, Time: fake.Now()})
+		}
+		fake.Advance(frameDuration)
+		game.update()
+	}
+	events = append(events, input.ReplayEvent{Frame: frames, Key: input.KeyEscape})
+	return events, game.engine.GetCurrentScore()
+}
+
+// TestReplayVerification tests that a recorded run reproduces its score and that
+// changing the physics changes it
+func TestReplayVerification(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	events, recorded := recordPilotRun(engine.NewDefaultConfig(), 42, 900)
+	if recorded == 0 {
+		t.Fatal("Expected the recorded run to score")
+	}
+
+	for i := 0; i < 3; i++ {
+		score, err := runReplay(engine.NewDefaultConfig(), 42, events)
+		if err != nil {
+			t.Fatalf("Replay failed: %v", err)
+		}
+		if score != recorded {
+			t.Fatalf("Replay %d: expected the recorded score %d, got %d", i, recorded, score)
+		}
+	}
+
+	heavier := engine.NewDefaultConfig()
+	heavier.Gravity *= 1.5
+	if score, _ := runReplay(heavier, 42, events); score == recorded {
+		t.Errorf("Expected stronger gravity to change the replayed score from %d", recorded)
+	}
+
+	// Verifying a replay file
+	path := filepath.Join(t.TempDir(), "run.replay")
+	var buf bytes.Buffer
+	input.WriteReplay(&buf, events)
+	if err := os.WriteFile(path, buf.Bytes(), 0644); err != nil {
+		t.Fatalf("Failed to write replay: %v", err)
+	}
+	if err := verifyReplay(engine.NewDefaultConfig(), path, 42, recorded); err != nil {
+		t.Errorf("Expected the replay to verify, got %v", err)
+	}
+	if err := verifyReplay(engine.NewDefaultConfig(), path, 42, recorded+1); err == nil {
+		t.Error("Expected a score mismatch to fail verification")
+	}
+	if err := verifyReplay(engine.NewDefaultConfig(), filepath.Join(t.TempDir(), "missing"), 42, recorded); err == nil {
+		t.Error("Expected a missing replay to fail verification")
+	}
+}
+
+// TestReplayDoesNotSave tests that replaying a run leaves the saved high score
+// and seed bests alone
+func TestReplayDoesNotSave(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	// Without any jumps the run ends at the first obstacle
+	result, err := runReplay(engine.NewDefaultConfig(), 42, nil)
+	if err != nil {
+		t.Fatalf("Replay failed: %v", err)
+	}
+	if result == 0 {
+		t.Fatal("Expected the replayed run to score")
+	}
+	if high, _ := score.LoadHighScore(); high != 0 {
+		t.Errorf("Expected the replay not to save a high score, got %d", high)
+	}
+	if best, _ := score.LoadSeedBest(42); best != 0 {
+		t.Errorf("Expected the replay not to save a best for seed 42, got %d", best)
+	}
+}
+
+// TestRecordedRunReplays tests that -record writes a finished run's key presses
+// so that replaying them on its seed scores the same
+func TestRecordedRunReplays(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	config := engine.NewDefaultConfig()
+	game, fake := newHeadlessGame(config)
+	game.recordPath = filepath.Join(t.TempDir(), "run.replay")
+	frameDuration := time.Second / time.Duration(config.TargetFPS)
+
+	// The pilot jumps for a while, then the run ends at the next obstacle
+	game.startGame()
+	for frame := 0; frame < 6000 && game.engine.GetState() == engine.StatePlaying; frame++ {
+		if frame < 600 && game.pilot.ShouldJump(game.dinosaur, game.spawner.GetObstacles()) {
+			game.handleInput(input.InputEvent{Key: input.KeySpace, Time: fake.Now()})
+		}
+		fake.Advance(frameDuration)
+		game.update()
+	}
+	if game.engine.GetState() != engine.StateGameOver {
+		t.Fatalf("Expected the run to end, got %v", game.engine.GetState())
+	}
+
+	events, err := loadReplay(game.recordPath)
+	if err != nil {
+		t.Fatalf("Failed to load the recording: %v", err)
+	}
+	if len(events) == 0 {
+		t.Fatal("Expected the recording to hold the run's jumps")
+	}
+	result, err := runReplay(engine.NewDefaultConfig(), game.engine.GetSeed(), events)
+	if err != nil {
+		t.Fatalf("Replay failed: %v", err)
+	}
+	if result != game.engine.GetCurrentScore() {
+		t.Errorf("Expected the replay to score %d like the recorded run, got %d", game.engine.GetCurrentScore(), result)
+	}
+
+	data, _ := os.ReadFile(game.recordPath)
+	header := fmt.Sprintf("# -seed=%d -difficulty=normal scored %d\n", game.engine.GetSeed(), result)
+	if !strings.HasPrefix(string(data), header) {
+		t.Errorf("Expected the recording to start with %q, got %q", header, strings.SplitAfter(string(data), "\n")[0])
+	}
+}
+
+// TestMenuRunsGetNewSeeds tests that without a fixed seed each run started from
+// the menu plays a different course
+func TestMenuRunsGetNewSeeds(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	game, _ := newHeadlessGame(engine.NewDefaultConfig())
+
+	game.startGame()
+	seed := game.engine.GetSeed()
+	game.engine.TriggerGameOver()
+	game.handleInput(input.InputEvent{Key: input.KeyEscape, Time: time.Now()})
+	if game.engine.GetState() != engine.StateMenu {
+		t.Fatalf("Expected Esc to return to the menu, got %v", game.engine.GetState())
+	}
+
+	game.startGame()
+	if game.engine.GetSeed() == seed {
+		t.Errorf("Expected a new seed for the second run, got %d again", seed)
+	}
+}
+
+// TestObstacleColorRamp tests that obstacles are tinted by how far their speed has climbed
+func TestObstacleColorRamp(t *testing.T) {
+	config := engine.NewDefaultConfig()
+	config.ObstacleColorRamp = engine.DefaultObstacleColorRamp
+	game, fake := newHeadlessGame(config)
+	backend := render.NewBufferBackend(config.ScreenWidth, config.ScreenHeight)
+	game.renderer = render.NewRendererWithBackend(backend)
+
+	// spawnAt spawns an obstacle and moves it on screen
+	spawnAt := func() *entities.Obstacle {
+		fake.Advance(5 * time.Second)
+		game.spawner.Update(0)
+		obstacles := game.spawner.GetObstacles()
+		if len(obstacles) == 0 {
+			t.Fatal("Expected an obstacle to spawn")
+		}
+		obstacle := obstacles[len(obstacles)-1]
+		obstacle.X = 40
+		return obstacle
+	}
+
+	// colorOf returns the color the obstacle's sprite is drawn in
+	colorOf := func(obstacle *entities.Obstacle) string {
+		game.renderer.Clear()
+		game.renderObstacles()
+		for y := int(obstacle.Y); y < int(obstacle.Y+obstacle.Height); y++ {
+			for x := 40; x < 40+int(obstacle.Width); x++ {
+				cell := backend.Cell(x, y)
+				if cell.Ch == ' ' {
+					continue
+				}
+				for _, name := range engine.PaletteColors {
+					if attr, _ := render.ResolveColor(name); attr == cell.Fg {
+						return name
+					}
+				}
+			}
+		}
+		return ""
+	}
+
+	obstacle := spawnAt()
+	if level := game.difficultyLevel(); level != 0 {
+		t.Errorf("Expected difficulty level 0 at the start, got %f", level)
+	}
+	if color := colorOf(obstacle); color != "green" {
+		t.Errorf("Expected obstacles green at the start, got %q", color)
+	}
+
+	// Long into the run the speed is capped and obstacles are red
+	game.spawner.Update(10000)
+	obstacle = spawnAt()
+	if level := game.difficultyLevel(); level != 1 {
+		t.Errorf("Expected difficulty level 1 at the speed cap, got %f", level)
+	}
+	if color := colorOf(obstacle); color != "red" {
+		t.Errorf("Expected obstacles red at the speed cap, got %q", color)
+	}
+
+	// With the ramp off obstacles keep their own colors
+	config.ObstacleColorRamp = nil
+	expected := config.CactusColor
+	switch obstacle.GetType() {
+	case entities.BirdLow, entities.BirdMid, entities.BirdHigh:
+		expected = config.BirdColor
+	}
+	if color := colorOf(obstacle); color != expected {
+		t.Errorf("Expected %v to be %q with the ramp off, got %q", obstacle.GetType(), expected, color)
+	}
+}
+
+// TestHotseatMatch tests that two players' runs are scored separately and the
+// results name the player with the higher score
+func TestHotseatMatch(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	config := engine.NewDefaultConfig()
+	game, fake := newHeadlessGame(config)
+	backend := render.NewBufferBackend(config.ScreenWidth, config.ScreenHeight)
+	game.renderer = render.NewRendererWithBackend(backend)
+	game.hotseat = engine.NewPlayerSession(2)
+	frameDuration := time.Second / time.Duration(config.TargetFPS)
+
+	// playTurn runs frames until the run ends, jumping with the pilot when autopilot
+	// is set and ending the run after frames, then waits out the death animation
+	playTurn := func(autopilot bool, frames int) int {
+		for frame := 0; game.engine.GetState() == engine.StatePlaying; frame++ {
+			if frame == frames {
+				game.engine.TriggerGameOver()
+				break
+			}
+			if autopilot && game.pilot.ShouldJump(game.dinosaur, game.spawner.GetObstacles()) {
+				game.dinosaur.Jump(config)
+			}
+			fake.Advance(frameDuration)
+			game.update()
+		}
+		score := game.engine.GetCurrentScore()
+		fake.Advance(time.Second)
+		return score
+	}
+
+	screen := func() string {
+		game.render()
+		var text strings.Builder
+		for y := 0; y < config.ScreenHeight; y++ {
+			text.WriteString(backend.Line(y))
+			text.WriteString("\n")
+		}
+		return text.String()
+	}
+
+	// Player 1 doesn't jump and crashes into the first obstacle
+	game.startGame()
+	seed := game.engine.GetSeed()
+	first := playTurn(false, 3000)
+	if game.hotseat.GetCurrentPlayer() != 2 || game.hotseat.GetScore(1) != first {
+		t.Fatalf("Expected player 1's score %d recorded and player 2 up, got %v with player %d next",
+			first, game.hotseat.GetScores(), game.hotseat.GetCurrentPlayer())
+	}
+	if text := screen(); !strings.Contains(text, "TURN OVER") || !strings.Contains(text, "Player 2, press Space") {
+		t.Errorf("Expected the between-turns screen, got:\n%s", text)
+	}
+
+	// Player 2 starts with a fresh score and outlasts player 1
+	game.handleInput(input.InputEvent{Key: input.KeySpace, Time: fake.Now()})
+	if game.engine.GetState() != engine.StatePlaying || game.engine.GetCurrentScore() != 0 {
+		t.Fatalf("Expected player 2's run to start from 0, got %v with score %d", game.engine.GetState(), game.engine.GetCurrentScore())
+	}
+	if game.engine.GetSeed() != seed {
+		t.Errorf("Expected player 2 to play player 1's seed %d, got %d", seed, game.engine.GetSeed())
+	}
+	second := playTurn(true, 600)
+	if second <= first {
+		t.Fatalf("Expected player 2 to outscore player 1's %d, got %d", first, second)
+	}
+
+	if !game.hotseat.IsComplete() || game.hotseat.GetScore(1) != first || game.hotseat.GetScore(2) != second {
+		t.Errorf("Expected scores %d and %d, got %v", first, second, game.hotseat.GetScores())
+	}
+	if winner, tie := game.hotseat.GetWinner(); winner != 2 || tie {
+		t.Errorf("Expected player 2 to win, got player %d (tie %v)", winner, tie)
+	}
+	if text := screen(); !strings.Contains(text, "RESULTS") || !strings.Contains(text, "Player 2 wins!") {
+		t.Errorf("Expected the results screen to name player 2, got:\n%s", text)
+	}
+
+	// Any key goes back to the menu, and starting again begins a new match
+	game.handleInput(input.InputEvent{Key: input.KeyEnter, Time: fake.Now()})
+	if game.engine.GetState() != engine.StateMenu {
+		t.Errorf("Expected the menu after the results, got %v", game.engine.GetState())
+	}
+	game.startGame()
+	if game.hotseat.GetCurrentPlayer() != 1 || len(game.hotseat.GetScores()) != 0 {
+		t.Errorf("Expected a fresh match, got player %d next with %v", game.hotseat.GetCurrentPlayer(), game.hotseat.GetScores())
+	}
+}
+
+// TestInjectedInput tests that keys injected into the input handler reach the
+// game the way the main loop delivers typed keys
+func TestInjectedInput(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	game, fake := newHeadlessGame(engine.NewDefaultConfig())
+	game.engine.SetState(engine.StateSplash)
+
+	// deliver hands every pending event to the game, as the main loop's select does
+	deliver := func() {
+		for {
+			select {
+			case event := <-game.inputHandler.GetInputChannel():
+				game.handleInput(event)
+			default:
+				return
+			}
+		}
+	}
+
+	// Any key leaves the splash screen, then Space starts a run from the menu
+	game.inputHandler.InjectEvent(input.InputEvent{Key: input.KeyUnknown, Time: fake.Now()})
+	game.inputHandler.InjectEvent(input.InputEvent{Key: input.KeySpace, Time: fake.Now()})
+	deliver()
+	if game.engine.GetState() != engine.StatePlaying {
+		t.Fatalf("Expected injected keys to start a run, got %v", game.engine.GetState())
+	}
+
+	game.inputHandler.InjectEvent(input.InputEvent{Key: input.KeyUp, Time: fake.Now()})
+	deliver()
+	if !game.dinosaur.IsJumping {
+		t.Error("Expected an injected Up to jump")
+	}
+}
+
+// TestRecoverPanicRestoresTerminal tests that a panic in the game loop hands the
+// terminal back before it is passed on
+func TestRecoverPanicRestoresTerminal(t *testing.T) {
+	config := engine.NewDefaultConfig()
+	game, _ := newHeadlessGame(config)
+	backend := render.NewBufferBackend(config.ScreenWidth, config.ScreenHeight)
+	game.renderer = render.NewRendererWithBackend(backend)
+
+	defer func() {
+		if r := recover(); r != "loop crashed" {
+			t.Errorf("Expected the panic to be passed on, got %v", r)
+		}
+		if !backend.IsClosed() {
+			t.Error("Expected the terminal to be restored on a panic")
+		}
+	}()
+
+	defer game.recoverPanic()
+	panic("loop crashed")
+}
+
+// TestSeedBestComparison tests that runs on a fixed seed are compared with the
+// best saved for that seed on the game over screen
+func TestSeedBestComparison(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	config := engine.NewDefaultConfig()
+	game, fake := newHeadlessGame(config)
+	backend := render.NewBufferBackend(config.ScreenWidth, config.ScreenHeight)
+	game.renderer = render.NewRendererWithBackend(backend)
+	frameDuration := time.Second / time.Duration(config.TargetFPS)
+
+	// playRun plays a run of the given number of frames, then shows the game over
+	// screen. No obstacles spawn, so the run lasts until it's ended.
+	playRun := func(frames int) string {
+		game.spawner.SetFrozen(true)
+		for i := 0; i < frames; i++ {
+			fake.Advance(frameDuration)
+			game.update()
+		}
+		game.engine.TriggerGameOver()
+		fake.Advance(time.Second)
+		game.render()
+
+		var text strings.Builder
+		for y := 0; y < config.ScreenHeight; y++ {
+			text.WriteString(backend.Line(y))
+			text.WriteString("\n")
+		}
+		return text.String()
+	}
+
+	// Random seeds aren't compared
+	game.startGame()
+	if text := playRun(30); strings.Contains(text, "for seed") {
+		t.Errorf("Expected no seed comparison without a fixed seed, got:\n%s", text)
+	}
+
+	game.engine.SetSeed(42)
+	game.restartGame()
+	if text := playRun(300); !strings.Contains(text, "First score for seed 42") {
+		t.Errorf("Expected the first run on the seed to say so, got:\n%s", text)
+	}
+	best := game.engine.GetCurrentScore()
+
+	game.restartGame()
+	text := playRun(60)
+	short := best - game.engine.GetCurrentScore()
+	if want := fmt.Sprintf("%d short of the best for seed 42 (%d)", short, best); !strings.Contains(text, want) {
+		t.Errorf("Expected %q on a worse run, got:\n%s", want, text)
+	}
+	if saved, _ := score.LoadSeedBest(42); saved != best {
+		t.Errorf("Expected the best for seed 42 to stay %d, got %d", best, saved)
+	}
+}
+
+// TestUnrankedRunsAreNotSaved tests that god mode and practice runs save neither
+// the high score nor the best for their seed, and say so on the game over screen
+func TestUnrankedRunsAreNotSaved(t *testing.T) {
+	tests := []struct {
+		name   string
+		setup  func(g *Game)
+		reason string
+	}{
+		{"god mode", func(g *Game) { g.engine.SetGodMode(true) }, "God mode: score not saved"},
+		{"practice", func(g *Game) { g.spawner.SetPracticeMode(entities.CactusSmall, practiceInterval) }, "Practice: score not saved"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("HOME", t.TempDir())
+
+			config := engine.NewDefaultConfig()
+			config.Seed = 42
+			game, fake := newHeadlessGame(config)
+			backend := render.NewBufferBackend(config.ScreenWidth, config.ScreenHeight)
+			game.renderer = render.NewRendererWithBackend(backend)
+			tt.setup(game)
+
+			game.startGame()
+			fake.Advance(5 * time.Second)
+			game.update()
+			game.engine.TriggerGameOver()
+			fake.Advance(time.Second)
+			game.render()
+
+			if game.engine.GetCurrentScore() == 0 {
+				t.Fatal("Expected the run to score points")
+			}
+			if high, _ := score.LoadHighScore(); high != 0 {
+				t.Errorf("Expected no high score saved, got %d", high)
+			}
+			if best, _ := score.LoadSeedBest(42); best != 0 {
+				t.Errorf("Expected no best saved for seed 42, got %d", best)
+			}
+			if line := backend.Line(config.ScreenHeight/2 + 1); !strings.Contains(line, tt.reason) {
+				t.Errorf("Expected %q on the game over screen, got %q", tt.reason, line)
+			}
+			if strings.Contains(backend.Line(config.ScreenHeight/2), "NEW HIGH SCORE") {
+				t.Error("Expected no new high score for a run that isn't saved")
+			}
+		})
+	}
+}
+
+// TestLeaderboard tests that the leaderboard lists the high score, then the
+// best for each fixed seed
+func TestLeaderboard(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	config := engine.NewDefaultConfig()
+	game, _ := newHeadlessGame(config)
+	backend := render.NewBufferBackend(config.ScreenWidth, config.ScreenHeight)
+	game.renderer = render.NewRendererWithBackend(backend)
+
+	score.SaveHighScore(900)
+	score.SaveSeedBest(7, 120)
+	score.SaveSeedBest(42, 450)
+
+	game.openLeaderboard()
+	if game.engine.GetState() != engine.StateLeaderboard {
+		t.Fatalf("Expected the leaderboard to open, got %v", game.engine.GetState())
+	}
+	game.render()
+
+	var text strings.Builder
+	for y := 0; y < config.ScreenHeight; y++ {
+		text.WriteString(backend.Line(y))
+		text.WriteString("\n")
+	}
+	for _, want := range []string{"1. Best run        900", "2. Seed 42         450", "3. Seed 7          120"} {
+		if !strings.Contains(text.String(), want) {
+			t.Errorf("Expected the leaderboard to contain %q, got:\n%s", want, text.String())
+		}
+	}
+}
+
+// TestSetSpriteScale tests that scaling magnifies the dinosaur and its hitbox
+// inset without changing the physics stored in the config
+func TestSetSpriteScale(t *testing.T) {
+	config := engine.NewDefaultConfig()
+	defaults := *config
+	game, _ := newHeadlessGame(config)
+
+	game.setSpriteScale(2)
+
+	if config.JumpVelocity != defaults.JumpVelocity || config.Gravity != defaults.Gravity || config.ObstacleSpeed != defaults.ObstacleSpeed {
+		t.Errorf("Expected the stored physics to stay unscaled, got %s", config)
+	}
+	if err := config.Validate(); err != nil {
+		t.Errorf("Expected the scaled config to stay valid: %v", err)
+	}
+	if insetX, insetY := game.dinosaur.GetHitboxInset(); insetX != 2*dinoHitboxInset || insetY != 2*dinoHitboxInset {
+		t.Errorf("Expected the hitbox inset doubled to %f, got %f x %f", 2*dinoHitboxInset, insetX, insetY)
+	}
+	if bottom := game.dinosaur.Y + game.dinosaur.Height; bottom != game.ground.ObstacleGroundY() {
+		t.Errorf("Expected the scaled dinosaur to stand on the ground at %f, got bottom %f", game.ground.ObstacleGroundY(), bottom)
+	}
+}
+
+// TestSummaryReturnsToMenuOnGameClock tests that the run summary times out on
+// the game's clock rather than the wall clock
+func TestSummaryReturnsToMenuOnGameClock(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	game, fake := newHeadlessGame(engine.NewDefaultConfig())
+	game.startGame()
+	game.engine.TriggerGameOver()
+	game.openSummary()
+
+	fake.Advance(summaryDuration - time.Second)
+	game.update()
+	if game.engine.GetState() != engine.StateSummary {
+		t.Fatalf("Expected the summary to stay up, got %v", game.engine.GetState())
+	}
+
+	fake.Advance(time.Second)
+	game.update()
+	if game.engine.GetState() != engine.StateMenu {
+		t.Errorf("Expected the summary to return to the menu after %v, got %v", summaryDuration, game.engine.GetState())
+	}
+}
+
+// TestAttractReturnsToMenuOnGameClock tests that the demo run times out on the
+// game's clock rather than the wall clock
+func TestAttractReturnsToMenuOnGameClock(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	game, fake := newHeadlessGame(engine.NewDefaultConfig())
+	game.engine.SetGodMode(true)
+	game.engine.SetState(engine.StateMenu)
+	game.startAttract()
+
+	fake.Advance(attractDuration - time.Second)
+	game.update()
+	if game.engine.GetState() != engine.StateAttract {
+		t.Fatalf("Expected the demo to keep playing, got %v", game.engine.GetState())
+	}
+
+	fake.Advance(time.Second)
+	game.update()
+	if game.engine.GetState() != engine.StateMenu {
+		t.Errorf("Expected the demo to return to the menu after %v, got %v", attractDuration, game.engine.GetState())
+	}
+}
+
+// TestShouldRenderSkipsBeforeThrottling tests that a frame skipped to catch up
+// doesn't use up the render throttle's interval
+func TestShouldRenderSkipsBeforeThrottling(t *testing.T) {
+	game, _ := newHeadlessGame(engine.NewDefaultConfig())
+	frameDuration := time.Second / 60
+
+	// Drawing every other frame, a frame behind after one slow frame
+	game.renderThrottle = engine.NewRenderThrottle(2 * frameDuration)
+	game.frameSkip = engine.NewFrameSkipper(frameDuration)
+	game.frameSkip.RecordFrame(2 * frameDuration)
+
+	var drawn []int
+	for frame := 1; frame <= 3; frame++ {
+		if game.shouldRender(frameDuration) {
+			drawn = append(drawn, frame)
+		}
+	}
+
+	// The skipped first frame leaves the throttle's two frames to run out on the third
+	if !reflect.DeepEqual(drawn, []int{3}) {
+		t.Errorf("Expected only frame 3 to be drawn, got %v", drawn)
+	}
+}
+
+// TestStartImmediately tests that the game can skip the splash screen and menu
+// and open straight into a run
+func TestStartImmediately(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	game, _ := newHeadlessGame(engine.NewDefaultConfig())
+	game.enterInitialState()
+	if game.engine.GetState() != engine.StateSplash {
+		t.Errorf("Expected the splash screen by default, got %v", game.engine.GetState())
+	}
+
+	game, _ = newHeadlessGame(engine.NewDefaultConfig())
+	game.startImmediately = true
+	game.enterInitialState()
+	if game.engine.GetState() != engine.StatePlaying {
+		t.Fatalf("Expected a run to start straight away, got %v", game.engine.GetState())
+	}
+	if game.engine.GetCurrentScore() != 0 || len(game.spawner.GetObstacles()) != 0 {
+		t.Errorf("Expected a fresh run, got score %d with %d obstacles", game.engine.GetCurrentScore(), len(game.spawner.GetObstacles()))
 	}
 }
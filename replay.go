@@ -0,0 +1,125 @@
+package main
+
+import (
+	"cli-dino-game/src/clock"
+	"cli-dino-game/src/engine"
+	"cli-dino-game/src/input"
+	"cli-dino-game/src/render"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+)
+
+// replayTimeLimit is how long a replayed run may last before it's abandoned
+const replayTimeLimit = 10 * time.Minute
+
+// newHeadlessGame creates a game that draws into an in-memory screen and runs on
+// a fake clock, so a run plays out the same way every time without a terminal.
+// The clock only moves when advanced, and the input handler is never started, so
+// it only delivers keys injected with InjectEvent.
+func newHeadlessGame(config *engine.Config) (*Game, *clock.FakeClock) {
+	fake := clock.NewFakeClock(time.Unix(0, 0))
+
+	renderer := render.NewRendererWithBackend(render.NewBufferBackend(config.ScreenWidth, config.ScreenHeight))
+	renderer.SetClock(fake)
+
+	game := newGame(config, renderer)
+	game.engine.SetClock(fake)
+	game.useRunClock()
+	game.running = true
+	return game, fake
+}
+
+// runReplay plays back a recorded run with the given seed, frame by frame from
+// the start of the run, and returns the final score. Frame 0 is the first frame
+// of the run, so recordings don't include the keys pressed in the menu.
+func runReplay(config *engine.Config, seed int64, events []input.ReplayEvent) (int, error) {
+	game, fake := newHeadlessGame(config)
+	frameDuration := time.Second / time.Duration(config.TargetFPS)
+
+	// Checking a recording shouldn't touch the player's saved scores
+	game.noSave = true
+	game.engine.SetSeed(seed)
+	game.startGame()
+
+	replay := input.NewReplay(events)
+	frames := int(replayTimeLimit / frameDuration)
+	for frame := 0; frame < frames; frame++ {
+		for _, key := range replay.KeysAt(frame) {
+			game.handleInput(input.InputEvent{Key: key, Time: fake.Now()})
+		}
+		if !game.running || game.engine.GetState() != engine.StatePlaying {
+			return game.engine.GetCurrentScore(), nil
+		}
+
+		fake.Advance(frameDuration)
+		game.update()
+		game.render()
+
+		if game.engine.GetState() == engine.StateGameOver {
+			return game.engine.GetCurrentScore(), nil
+		}
+	}
+	return game.engine.GetCurrentScore(), fmt.Errorf("replay still running after %v", replayTimeLimit)
+}
+
+// startRecording starts recording a new run's key presses from frame 0
+func (g *Game) startRecording() {
+	g.runFrames = 0
+	g.recording = g.recording[:0]
+}
+
+// recordKey records a key pressed during the run on the frame it arrived before
+func (g *Game) recordKey(key input.Key) {
+	if g.recordPath == "" {
+		return
+	}
+	g.recording = append(g.recording, input.ReplayEvent{Frame: g.runFrames, Key: key})
+}
+
+// saveRecording writes the finished run's key presses to the -record file,
+// headed by the seed, difficulty, and score to replay it with. A failed write
+// is dropped, since the terminal belongs to the game.
+func (g *Game) saveRecording() {
+	if g.recordPath == "" {
+		return
+	}
+	file, err := os.Create(g.recordPath)
+	if err != nil {
+		return
+	}
+	defer file.Close()
+
+	fmt.Fprintf(file, "# -seed=%d -difficulty=%s scored %d\n", g.engine.GetSeed(), strings.ToLower(g.config.Difficulty.String()), g.engine.GetCurrentScore())
+	input.WriteReplay(file, g.recording)
+}
+
+// loadReplay reads the run recorded at path
+func loadReplay(path string) ([]input.ReplayEvent, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open replay: %w", err)
+	}
+	defer file.Close()
+
+	return input.ReadReplay(file)
+}
+
+// verifyReplay replays the run recorded at path and checks that it scores
+// expected, for catching physics and scoring changes that alter old runs
+func verifyReplay(config *engine.Config, path string, seed int64, expected int) error {
+	events, err := loadReplay(path)
+	if err != nil {
+		return err
+	}
+
+	score, err := runReplay(config, seed, events)
+	if err != nil {
+		return err
+	}
+	if score != expected {
+		return fmt.Errorf("replay scored %d, expected %d", score, expected)
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+// Package autoplay decides when the dinosaur should jump, so the game can play
+// itself for demos.
+package autoplay
+
+import (
+	"cli-dino-game/src/engine"
+	"cli-dino-game/src/entities"
+)
+
+// Pilot jumps the dinosaur over incoming obstacles
+type Pilot struct {
+	config *engine.Config
+}
+
+// NewPilot creates a pilot that times its jumps for the configured jump physics
+func NewPilot(config *engine.Config) *Pilot {
+	return &Pilot{config: config}
+}
+
+// ShouldJump reports whether the dinosaur should jump now to clear the nearest
+// obstacle in its path
+func (p *Pilot) ShouldJump(dino *entities.Dinosaur, obstacles []*entities.Obstacle) bool {
+	if !dino.IsOnGround() || dino.IsDead || p.config.Gravity <= 0 {
+		return false
+	}
+
+	standing := dino.GetBounds()
+	front := standing.X + standing.Width
+	airTime := 2 * p.config.GetJumpVelocity() / p.config.GetGravity()
+
+	for _, obstacle := range obstacles {
+		if !obstacle.IsActive() || obstacle.GetSpeed() <= 0 {
+			continue
+		}
+
+		bounds := obstacle.GetBounds()
+		if bounds.X+bounds.Width < standing.X {
+			continue // Already passed
+		}
+		if bounds.Y >= standing.Y+standing.Height || bounds.Y+bounds.Height <= standing.Y {
+			continue // Misses a standing dinosaur
+		}
+
+		// Jump so the peak of the jump lands midway through crossing the obstacle
+		speed := obstacle.GetSpeed()
+		gap := bounds.X - front
+		crossTime := (standing.Width + bounds.Width) / speed
+		if gap/speed <= airTime/2-crossTime/2 {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,67 @@
+package autoplay
+
+import (
+	"cli-dino-game/src/engine"
+	"cli-dino-game/src/entities"
+	"testing"
+)
+
+func TestPilotShouldJump(t *testing.T) {
+	config := engine.NewDefaultConfig()
+	pilot := NewPilot(config)
+	dino := entities.NewDinosaur(15.0)
+	ground := dino.GroundLevel + dino.Height
+
+	tests := []struct {
+		name     string
+		x        float64
+		active   bool
+		expected bool
+	}{
+		{"far ahead", 70, true, false},
+		{"close ahead", 23, true, true},
+		{"already passed", 2, true, false},
+		{"inactive", 23, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			obstacle := entities.NewObstacle(entities.CactusSmall, tt.x, ground, config)
+			if !tt.active {
+				obstacle.Deactivate()
+			}
+			if got := pilot.ShouldJump(dino, []*entities.Obstacle{obstacle}); got != tt.expected {
+				t.Errorf("Expected ShouldJump %v, got %v", tt.expected, got)
+			}
+		})
+	}
+
+	// No jumping while already in the air
+	dino.Jump(config)
+	obstacle := entities.NewObstacle(entities.CactusSmall, 23, ground, config)
+	if pilot.ShouldJump(dino, []*entities.Obstacle{obstacle}) {
+		t.Error("Expected no jump while airborne")
+	}
+}
+
+func TestPilotClearsCactus(t *testing.T) {
+	config := engine.NewDefaultConfig()
+	pilot := NewPilot(config)
+	detector := engine.NewCollisionDetector()
+	dino := entities.NewDinosaur(15.0)
+	obstacle := entities.NewObstacle(entities.CactusSmall, 80, dino.GroundLevel+dino.Height, config)
+
+	// Step the world at 60 FPS until the cactus is well behind the dinosaur
+	const dt = 1.0 / 60
+	for obstacle.X > 0 {
+		if pilot.ShouldJump(dino, []*entities.Obstacle{obstacle}) {
+			dino.Jump(config)
+		}
+		dino.Update(dt, config)
+		obstacle.Update(dt)
+
+		if detector.CheckCollision(dino.GetBounds(), obstacle.GetBounds()) {
+			t.Fatalf("Expected the pilot to clear the cactus, hit it at X=%.1f", obstacle.X)
+		}
+	}
+}
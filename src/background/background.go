@@ -3,6 +3,7 @@ package background
 import (
 	"math"
 	"math/rand"
+	"sort"
 	"time"
 )
 
@@ -15,9 +16,23 @@ const (
 	Mountain
 )
 
+// Depth returns how far back elements of this type sit. Deeper elements are
+// drawn first so shallower ones cover them: mountains < hills < clouds.
+func (t BackgroundElementType) Depth() int {
+	switch t {
+	case Mountain:
+		return 0
+	case Hill:
+		return 1
+	default:
+		return 2 // Clouds sit behind everything
+	}
+}
+
 // BackgroundElement represents a decorative background element
 type BackgroundElement struct {
 	Type    BackgroundElementType
+	Depth   int     // How far back the element sits; deeper elements are drawn first
 	X       float64 // Horizontal position
 	Y       float64 // Vertical position
 	Width   float64 // Width for positioning
@@ -25,8 +40,24 @@ type BackgroundElement struct {
 	Speed   float64 // Scroll speed (slower than obstacles for parallax effect)
 	Active  bool    // Whether the element is active
 	Variant int     // Different variants of the same type
+
+	animTime  float64 // Seconds since the cloud last changed shape
+	animFrame int     // How many shapes the cloud has drifted through
 }
 
+// cloudVariants is the number of cloud shapes, and cloudMorphInterval how long
+// an animated cloud holds each shape before drifting into the next
+const (
+	cloudVariants      = 3
+	cloudMorphInterval = 2.0
+)
+
+// Hill heights are clamped to this range so hills stay behind the play line
+const (
+	minHillHeight = 2.0
+	maxHillHeight = 15.0 // Good height for dramatic but not overwhelming hills
+)
+
 // HillProfile represents a continuous hill landscape
 type HillProfile struct {
 	heights []float64 // Height at each X position
@@ -44,16 +75,23 @@ type BackgroundManager struct {
 	groundLevel    float64
 	rng            *rand.Rand
 	lastCloudSpawn time.Time
+	cloudSpawnMin  time.Duration // Shortest time between clouds
+	cloudSpawnMax  time.Duration // Longest time between clouds
+	density        float64       // Fraction of background detail to show (0.1-1.0)
+	animateClouds  bool          // Whether clouds cycle through their shapes over time
 }
 
 // NewBackgroundManager creates a new background manager
 func NewBackgroundManager(screenWidth, screenHeight, groundLevel float64) *BackgroundManager {
 	bm := &BackgroundManager{
-		elements:     make([]*BackgroundElement, 0, 20),
-		screenWidth:  screenWidth,
-		screenHeight: screenHeight,
-		groundLevel:  groundLevel,
-		rng:          rand.New(rand.NewSource(time.Now().UnixNano())),
+		elements:      make([]*BackgroundElement, 0, 20),
+		screenWidth:   screenWidth,
+		screenHeight:  screenHeight,
+		groundLevel:   groundLevel,
+		rng:           rand.New(rand.NewSource(time.Now().UnixNano())),
+		cloudSpawnMin: 15 * time.Second,
+		cloudSpawnMax: 30 * time.Second,
+		density:       1.0,
 	}
 	
 	// Create continuous hill profile
@@ -86,11 +124,11 @@ func (bm *BackgroundManager) generateHillProfile() *HillProfile {
 		totalHeight := baseHeight + mediumHeight + smallHeight + (bm.rng.Float64()-0.5)*1.0
 		
 		// Ensure minimum height and reasonable maximum
-		if totalHeight < 2.0 {
-			totalHeight = 2.0
+		if totalHeight < minHillHeight {
+			totalHeight = minHillHeight
 		}
-		if totalHeight > 15.0 { // Good height for dramatic but not overwhelming hills
-			totalHeight = 15.0
+		if totalHeight > maxHillHeight {
+			totalHeight = maxHillHeight
 		}
 		
 		heights[x] = totalHeight
@@ -121,6 +159,14 @@ func (bm *BackgroundManager) Update(deltaTime float64) {
 		element := bm.elements[i]
 		element.X -= element.Speed * deltaTime
 
+		if bm.animateClouds && element.Type == Cloud {
+			element.animTime += deltaTime
+			for element.animTime >= cloudMorphInterval {
+				element.animTime -= cloudMorphInterval
+				element.animFrame = (element.animFrame + 1) % cloudVariants
+			}
+		}
+
 		// Remove elements that have moved off-screen
 		if element.X+element.Width < -20 {
 			bm.removeElement(i)
@@ -132,8 +178,13 @@ func (bm *BackgroundManager) Update(deltaTime float64) {
 func (bm *BackgroundManager) spawnElements() {
 	now := time.Now()
 
-	// Spawn clouds every 15-30 seconds
-	if now.Sub(bm.lastCloudSpawn) > time.Duration(15000+bm.rng.Intn(15000))*time.Millisecond {
+	// Spawn clouds within the configured interval, less often at reduced density
+	interval := bm.cloudSpawnMin
+	if spread := bm.cloudSpawnMax - bm.cloudSpawnMin; spread > 0 {
+		interval += time.Duration(bm.rng.Int63n(int64(spread)))
+	}
+	interval = time.Duration(float64(interval) / bm.density)
+	if now.Sub(bm.lastCloudSpawn) > interval {
 		bm.spawnCloud()
 		bm.lastCloudSpawn = now
 	}
@@ -147,13 +198,14 @@ func (bm *BackgroundManager) spawnCloud() {
 
 	cloud := &BackgroundElement{
 		Type:    Cloud,
+		Depth:   Cloud.Depth(),
 		X:       bm.screenWidth + 10,
 		Y:       cloudY,
 		Width:   12 + bm.rng.Float64()*8, // Variable width clouds
 		Height:  2 + bm.rng.Float64()*1,  // Variable height clouds
 		Speed:   3 + bm.rng.Float64()*2,  // Slow parallax movement
 		Active:  true,
-		Variant: bm.rng.Intn(3), // 3 different cloud shapes
+		Variant: bm.rng.Intn(cloudVariants),
 	}
 	bm.elements = append(bm.elements, cloud)
 }
@@ -191,6 +243,15 @@ func (bm *BackgroundManager) GetElements() []*BackgroundElement {
 	return bm.elements
 }
 
+// GetElementsByDepth returns all active background elements ordered deepest first,
+// the order they should be drawn in. Elements at the same depth keep their order.
+func (bm *BackgroundManager) GetElementsByDepth() []*BackgroundElement {
+	sort.SliceStable(bm.elements, func(i, j int) bool {
+		return bm.elements[i].Depth > bm.elements[j].Depth
+	})
+	return bm.elements
+}
+
 // Reset clears all background elements
 func (bm *BackgroundManager) Reset() {
 	bm.elements = bm.elements[:0]
@@ -199,12 +260,71 @@ func (bm *BackgroundManager) Reset() {
 	bm.hillProfile = bm.generateHillProfile()
 }
 
+// SetCloudSpawnInterval sets the range of time between cloud spawns
+func (bm *BackgroundManager) SetCloudSpawnInterval(min, max time.Duration) {
+	if min < 0 {
+		min = 0
+	}
+	if max < min {
+		max = min
+	}
+	bm.cloudSpawnMin = min
+	bm.cloudSpawnMax = max
+}
+
+// GetCloudSpawnInterval returns the range of time between cloud spawns
+func (bm *BackgroundManager) GetCloudSpawnInterval() (time.Duration, time.Duration) {
+	return bm.cloudSpawnMin, bm.cloudSpawnMax
+}
+
+// SetDensity sets the fraction of background detail to show, clamped to 0.1-1.0.
+// Lower densities spawn clouds less often and drop existing ones to match.
+func (bm *BackgroundManager) SetDensity(density float64) {
+	if density < 0.1 {
+		density = 0.1
+	}
+	if density > 1.0 {
+		density = 1.0
+	}
+
+	if density < bm.density {
+		keep := int(math.Ceil(float64(len(bm.elements)) * density / bm.density))
+		if keep < len(bm.elements) {
+			bm.elements = bm.elements[:keep]
+		}
+	}
+	bm.density = density
+}
+
+// GetDensity returns the fraction of background detail being shown
+func (bm *BackgroundManager) GetDensity() float64 {
+	return bm.density
+}
+
+// SetCloudAnimation enables or disables clouds slowly drifting between shapes
+func (bm *BackgroundManager) SetCloudAnimation(enabled bool) {
+	bm.animateClouds = enabled
+}
+
+// IsCloudAnimationEnabled returns whether clouds drift between shapes
+func (bm *BackgroundManager) IsCloudAnimationEnabled() bool {
+	return bm.animateClouds
+}
+
+// SetSeed reseeds the random number generator so hills and clouds are reproducible
+func (bm *BackgroundManager) SetSeed(seed int64) {
+	bm.rng = rand.New(rand.NewSource(seed))
+}
+
 // GetSprite returns the sprite for a background element
 func (be *BackgroundElement) GetSprite(useUnicode bool) []string {
+	// Animated clouds step through the shapes starting from their own variant
+	variant := (be.Variant + be.animFrame) % cloudVariants
+
 	if useUnicode {
 		switch be.Type {
 		case Cloud:
-			switch be.Variant {
+			switch variant {
 			case 0:
 				return []string{
 					"  ☁☁☁☁☁☁☁☁  ",
@@ -227,7 +347,7 @@ func (be *BackgroundElement) GetSprite(useUnicode bool) []string {
 		// ASCII versions
 		switch be.Type {
 		case Cloud:
-			switch be.Variant {
+			switch variant {
 			case 0:
 				return []string{
 					"    .-~~~-.    ",
@@ -0,0 +1,177 @@
+package background
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBackgroundManagerSetDensity(t *testing.T) {
+	bm := NewBackgroundManager(80, 20, 19)
+	for i := 0; i < 8; i++ {
+		bm.spawnCloud()
+	}
+
+	if bm.GetDensity() != 1.0 {
+		t.Errorf("Expected default density 1.0, got %f", bm.GetDensity())
+	}
+
+	bm.SetDensity(0.5)
+	if len(bm.GetElements()) != 4 {
+		t.Errorf("Expected half of 8 clouds to remain at density 0.5, got %d", len(bm.GetElements()))
+	}
+
+	// Raising density again does not resurrect removed clouds
+	bm.SetDensity(1.0)
+	if len(bm.GetElements()) != 4 {
+		t.Errorf("Expected 4 clouds after raising density, got %d", len(bm.GetElements()))
+	}
+
+	// Density is clamped to a sensible range
+	bm.SetDensity(0)
+	if bm.GetDensity() != 0.1 {
+		t.Errorf("Expected density clamped to 0.1, got %f", bm.GetDensity())
+	}
+	bm.SetDensity(5)
+	if bm.GetDensity() != 1.0 {
+		t.Errorf("Expected density clamped to 1.0, got %f", bm.GetDensity())
+	}
+}
+
+func TestHillHeightsVaryWithinRange(t *testing.T) {
+	bm := NewBackgroundManager(80, 20, 19)
+	bm.SetSeed(42)
+	bm.Reset()
+
+	lowest, highest := maxHillHeight, minHillHeight
+	for x := 0; x < 80; x++ {
+		h := bm.GetHillHeightAt(float64(x))
+		if h < minHillHeight || h > maxHillHeight {
+			t.Errorf("Hill height %.2f at x=%d outside %.1f-%.1f", h, x, minHillHeight, maxHillHeight)
+		}
+		if h < lowest {
+			lowest = h
+		}
+		if h > highest {
+			highest = h
+		}
+	}
+
+	if highest-lowest < 1.0 {
+		t.Errorf("Expected rolling hills across the screen, heights only ranged %.2f-%.2f", lowest, highest)
+	}
+
+	// Scrolling moves the profile, so the same column shows a different height
+	before := bm.GetHillHeightAt(0)
+	bm.Update(2.0)
+	if bm.GetHillHeightAt(0) == before {
+		t.Error("Expected hill height at a fixed column to change as hills scroll")
+	}
+}
+
+func TestBackgroundManagerCloudSpawnInterval(t *testing.T) {
+	bm := NewBackgroundManager(80, 20, 19)
+
+	min, max := bm.GetCloudSpawnInterval()
+	if min != 15*time.Second || max != 30*time.Second {
+		t.Errorf("Expected default interval 15s-30s, got %v-%v", min, max)
+	}
+
+	bm.SetCloudSpawnInterval(10*time.Millisecond, 20*time.Millisecond)
+
+	// Nothing spawns before the window opens
+	bm.lastCloudSpawn = time.Now()
+	bm.Update(0)
+	if len(bm.GetElements()) != 0 {
+		t.Errorf("Expected no cloud before the spawn interval, got %d", len(bm.GetElements()))
+	}
+
+	// Once the longest interval has passed a cloud must have spawned
+	bm.lastCloudSpawn = time.Now().Add(-25 * time.Millisecond)
+	bm.Update(0)
+	if len(bm.GetElements()) != 1 {
+		t.Errorf("Expected a cloud after the spawn interval, got %d", len(bm.GetElements()))
+	}
+
+	// Invalid ranges are corrected
+	bm.SetCloudSpawnInterval(time.Second, time.Millisecond)
+	min, max = bm.GetCloudSpawnInterval()
+	if min != time.Second || max != time.Second {
+		t.Errorf("Expected max raised to min, got %v-%v", min, max)
+	}
+}
+
+func TestBackgroundManagerCloudAnimation(t *testing.T) {
+	bm := NewBackgroundManager(80, 20, 19)
+	bm.SetCloudSpawnInterval(time.Hour, time.Hour)
+	bm.lastCloudSpawn = time.Now()
+	bm.spawnCloud()
+	cloud := bm.GetElements()[0]
+
+	// Clouds keep their shape by default
+	initial := cloud.GetSprite(true)
+	bm.Update(cloudMorphInterval)
+	if cloud.GetSprite(true)[0] != initial[0] {
+		t.Error("Expected clouds not to change shape with animation disabled")
+	}
+
+	bm.SetCloudAnimation(true)
+	if !bm.IsCloudAnimationEnabled() {
+		t.Fatal("Expected cloud animation to be enabled")
+	}
+
+	// Each morph interval moves the cloud on to a different shape, then back around
+	seen := map[string]bool{initial[0]: true}
+	previous := initial
+	for i := 0; i < cloudVariants-1; i++ {
+		bm.Update(cloudMorphInterval)
+		sprite := cloud.GetSprite(true)
+		if len(sprite) == len(previous) && sprite[0] == previous[0] {
+			t.Errorf("Expected the cloud sprite to change after update %d", i+1)
+		}
+		seen[sprite[0]] = true
+		previous = sprite
+	}
+	if len(seen) != cloudVariants {
+		t.Errorf("Expected the cloud to cycle through %d shapes, saw %d", cloudVariants, len(seen))
+	}
+
+	bm.Update(cloudMorphInterval)
+	if cloud.GetSprite(true)[0] != initial[0] {
+		t.Error("Expected the cloud to return to its first shape after a full cycle")
+	}
+}
+
+func TestBackgroundElementDepthOrder(t *testing.T) {
+	if !(Mountain.Depth() < Hill.Depth() && Hill.Depth() < Cloud.Depth()) {
+		t.Fatalf("Expected mountains < hills < clouds, got %d, %d, %d",
+			Mountain.Depth(), Hill.Depth(), Cloud.Depth())
+	}
+
+	bm := NewBackgroundManager(80, 20, 19)
+	bm.spawnCloud()
+	if depth := bm.GetElements()[0].Depth; depth != Cloud.Depth() {
+		t.Errorf("Expected spawned clouds at cloud depth %d, got %d", Cloud.Depth(), depth)
+	}
+
+	// Mix the types up, with two clouds to check equal depths keep their order
+	first := &BackgroundElement{Type: Cloud, Depth: Cloud.Depth(), X: 1}
+	second := &BackgroundElement{Type: Cloud, Depth: Cloud.Depth(), X: 2}
+	bm.elements = []*BackgroundElement{
+		{Type: Hill, Depth: Hill.Depth()},
+		first,
+		{Type: Mountain, Depth: Mountain.Depth()},
+		{Type: Hill, Depth: Hill.Depth()},
+		second,
+	}
+
+	expected := []BackgroundElementType{Cloud, Cloud, Hill, Hill, Mountain}
+	elements := bm.GetElementsByDepth()
+	for i, element := range elements {
+		if element.Type != expected[i] {
+			t.Fatalf("Expected draw order %v, got type %v at %d", expected, element.Type, i)
+		}
+	}
+	if elements[0] != first || elements[1] != second {
+		t.Error("Expected clouds at the same depth to keep their order")
+	}
+}
@@ -0,0 +1,113 @@
+// Package clock provides the time source game components use, so tests can
+// control time instead of sleeping.
+package clock
+
+import (
+	"sync"
+	"time"
+)
+
+// Clock reports the current time
+type Clock interface {
+	Now() time.Time
+}
+
+// realClock reads the system clock
+type realClock struct{}
+
+// Now returns the current system time
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+// Real is the system clock used outside of tests
+var Real Clock = realClock{}
+
+// FakeClock is a Clock that only moves when told to
+type FakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+// NewFakeClock creates a fake clock stopped at the given time
+func NewFakeClock(start time.Time) *FakeClock {
+	return &FakeClock{now: start}
+}
+
+// Now returns the fake clock's current time
+func (c *FakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// Advance moves the fake clock forward by d
+func (c *FakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+// Set moves the fake clock to t
+func (c *FakeClock) Set(t time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = t
+}
+
+// PausableClock follows another clock, but stands still while paused, so time
+// spent paused doesn't count
+type PausableClock struct {
+	mu       sync.Mutex
+	base     Clock
+	offset   time.Duration // How far behind the base clock this clock runs
+	pausedAt time.Time     // Base time when the clock was paused, zero while running
+}
+
+// NewPausableClock creates a running clock that follows base
+func NewPausableClock(base Clock) *PausableClock {
+	return &PausableClock{base: base}
+}
+
+// Now returns the base clock's time less the time spent paused
+func (c *PausableClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.pausedAt.IsZero() {
+		return c.pausedAt.Add(-c.offset)
+	}
+	return c.base.Now().Add(-c.offset)
+}
+
+// Pause stops the clock until Resume. Pausing a paused clock does nothing.
+func (c *PausableClock) Pause() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.pausedAt.IsZero() {
+		c.pausedAt = c.base.Now()
+	}
+}
+
+// Resume starts a paused clock again from the time it was paused at
+func (c *PausableClock) Resume() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.pausedAt.IsZero() {
+		c.offset += c.base.Now().Sub(c.pausedAt)
+		c.pausedAt = time.Time{}
+	}
+}
+
+// IsPaused returns whether the clock is standing still
+func (c *PausableClock) IsPaused() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return !c.pausedAt.IsZero()
+}
+
+// Advance moves the clock forward by d, even while it is paused
+func (c *PausableClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.offset -= d
+}
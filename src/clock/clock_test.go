@@ -0,0 +1,73 @@
+package clock
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRealClock(t *testing.T) {
+	before := time.Now()
+	now := Real.Now()
+	if now.Before(before) || now.After(time.Now()) {
+		t.Errorf("Expected the real clock to report the current time, got %v", now)
+	}
+}
+
+func TestFakeClock(t *testing.T) {
+	start := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	fake := NewFakeClock(start)
+
+	if !fake.Now().Equal(start) {
+		t.Errorf("Expected fake clock to start at %v, got %v", start, fake.Now())
+	}
+
+	// Time stands still until advanced
+	if !fake.Now().Equal(start) {
+		t.Error("Expected fake clock not to move on its own")
+	}
+
+	fake.Advance(1500 * time.Millisecond)
+	if got := fake.Now().Sub(start); got != 1500*time.Millisecond {
+		t.Errorf("Expected the clock to advance 1.5s, got %v", got)
+	}
+
+	later := start.Add(time.Hour)
+	fake.Set(later)
+	if !fake.Now().Equal(later) {
+		t.Errorf("Expected fake clock set to %v, got %v", later, fake.Now())
+	}
+}
+
+func TestPausableClock(t *testing.T) {
+	start := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	base := NewFakeClock(start)
+	c := NewPausableClock(base)
+
+	base.Advance(time.Second)
+	if got := c.Now().Sub(start); got != time.Second {
+		t.Errorf("Expected a running clock to follow its base, got %v", got)
+	}
+
+	// Time spent paused doesn't count
+	c.Pause()
+	c.Pause()
+	base.Advance(5 * time.Second)
+	if !c.IsPaused() || c.Now().Sub(start) != time.Second {
+		t.Errorf("Expected a paused clock to stand still at 1s, got %v", c.Now().Sub(start))
+	}
+
+	// A paused clock can still be stepped forward
+	c.Advance(100 * time.Millisecond)
+	if got := c.Now().Sub(start); got != 1100*time.Millisecond {
+		t.Errorf("Expected an advanced paused clock at 1.1s, got %v", got)
+	}
+
+	c.Resume()
+	if c.IsPaused() || c.Now().Sub(start) != 1100*time.Millisecond {
+		t.Errorf("Expected the clock to resume from 1.1s, got %v", c.Now().Sub(start))
+	}
+	base.Advance(time.Second)
+	if got := c.Now().Sub(start); got != 2100*time.Millisecond {
+		t.Errorf("Expected the resumed clock to follow its base again, got %v", got)
+	}
+}
@@ -2,12 +2,29 @@ package engine
 
 import (
 	"fmt"
+	"io"
 )
 
 // CollisionDetector handles collision detection between game entities
 type CollisionDetector struct {
 	// Debug mode for collision detection
-	debugMode bool
+	debugMode   bool
+	debugOutput io.Writer // Where debug lines go; nil uses the engine debug log
+}
+
+// Collidable is any entity with a collision box that can be switched off.
+// Entities satisfy it so engine code never needs to import them.
+type Collidable interface {
+	GetBounds() Rectangle
+	IsActive() bool
+}
+
+// Scorable is an obstacle that can be retired and scored once it is passed
+type Scorable interface {
+	Collidable
+	Deactivate()
+	IsScored() bool
+	MarkScored()
 }
 
 // NewCollisionDetector creates a new collision detector
@@ -22,17 +39,34 @@ func (cd *CollisionDetector) SetDebugMode(enabled bool) {
 	cd.debugMode = enabled
 }
 
+// SetDebugOutput sends this detector's debug lines to w instead of the engine
+// debug log. A nil writer restores the engine debug log.
+func (cd *CollisionDetector) SetDebugOutput(w io.Writer) {
+	cd.debugOutput = w
+}
+
 // CheckCollision performs AABB collision detection between two rectangles
 func (cd *CollisionDetector) CheckCollision(rect1, rect2 Rectangle) bool {
 	collision := rect1.Intersects(rect2)
 
 	if cd.debugMode && collision {
-		fmt.Printf("Collision detected: %s intersects %s\n", rect1.String(), rect2.String())
+		overlapX, overlapY := overlap(rect1, rect2)
+		cd.logf("Collision detected: %s intersects %s (overlap %.2fx%.2f, area %.2f)",
+			rect1.String(), rect2.String(), overlapX, overlapY, overlapX*overlapY)
 	}
 
 	return collision
 }
 
+// logf writes a debug line to the detector's output or the engine debug log
+func (cd *CollisionDetector) logf(format string, args ...interface{}) {
+	if cd.debugOutput != nil {
+		fmt.Fprintf(cd.debugOutput, format+"\n", args...)
+		return
+	}
+	debugf(format, args...)
+}
+
 // CheckCollisionWithTolerance performs collision detection with a tolerance margin
 // This can be used to make the game more forgiving by reducing the effective collision area
 func (cd *CollisionDetector) CheckCollisionWithTolerance(rect1, rect2 Rectangle, tolerance float64) bool {
@@ -78,17 +112,20 @@ func (cd *CollisionDetector) GetCollisionInfo(rect1, rect2 Rectangle) CollisionI
 		return info
 	}
 
-	// Calculate overlap dimensions
+	info.OverlapX, info.OverlapY = overlap(rect1, rect2)
+	info.OverlapArea = info.OverlapX * info.OverlapY
+
+	return info
+}
+
+// overlap returns the width and height of the intersection of two rectangles
+func overlap(rect1, rect2 Rectangle) (float64, float64) {
 	overlapLeft := max(rect1.X, rect2.X)
 	overlapRight := min(rect1.X+rect1.Width, rect2.X+rect2.Width)
 	overlapTop := max(rect1.Y, rect2.Y)
 	overlapBottom := min(rect1.Y+rect1.Height, rect2.Y+rect2.Height)
 
-	info.OverlapX = overlapRight - overlapLeft
-	info.OverlapY = overlapBottom - overlapTop
-	info.OverlapArea = info.OverlapX * info.OverlapY
-
-	return info
+	return overlapRight - overlapLeft, overlapBottom - overlapTop
 }
 
 // Helper functions for min/max
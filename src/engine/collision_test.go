@@ -1,6 +1,9 @@
 package engine
 
 import (
+	"bytes"
+	"io"
+	"strings"
 	"testing"
 )
 
@@ -30,6 +33,75 @@ func TestSetDebugMode(t *testing.T) {
 	}
 }
 
+func TestSetDebugOutput(t *testing.T) {
+	cd := NewCollisionDetector()
+
+	var buf bytes.Buffer
+	cd.SetDebugOutput(&buf)
+	cd.SetDebugMode(true)
+
+	rect1 := Rectangle{X: 0, Y: 0, Width: 10, Height: 10}
+	rect2 := Rectangle{X: 5, Y: 8, Width: 10, Height: 10}
+	cd.CheckCollision(rect1, rect2)
+
+	logLine := buf.String()
+	if !strings.Contains(logLine, "Collision detected") {
+		t.Errorf("Expected collision to be logged, got %q", logLine)
+	}
+	if !strings.Contains(logLine, "overlap 5.00x2.00, area 10.00") {
+		t.Errorf("Expected overlap details in the log, got %q", logLine)
+	}
+
+	// Misses are not logged
+	buf.Reset()
+	cd.CheckCollision(rect1, Rectangle{X: 50, Y: 50, Width: 1, Height: 1})
+	if buf.Len() != 0 {
+		t.Errorf("Expected no log for a miss, got %q", buf.String())
+	}
+
+	// Nothing is logged with debug mode off
+	cd.SetDebugMode(false)
+	cd.CheckCollision(rect1, rect2)
+	if buf.Len() != 0 {
+		t.Errorf("Expected no log with debug mode off, got %q", buf.String())
+	}
+
+	// A nil writer falls back to the engine debug log
+	cd.SetDebugOutput(nil)
+	if cd.debugOutput != nil {
+		t.Error("Nil debug output should restore the engine debug log")
+	}
+}
+
+func TestCollisionDebugUsesEngineDebugLog(t *testing.T) {
+	defer SetDebugOutput(nil)
+
+	cd := NewCollisionDetector()
+	cd.SetDebugMode(true)
+	rect1 := Rectangle{X: 0, Y: 0, Width: 10, Height: 10}
+	rect2 := Rectangle{X: 5, Y: 5, Width: 10, Height: 10}
+
+	// By default the debug log discards everything, keeping stdout clean
+	if debugLogger.Writer() != io.Discard {
+		t.Error("Engine debug log should discard by default")
+	}
+
+	var buf bytes.Buffer
+	SetDebugOutput(&buf)
+	cd.CheckCollision(rect1, rect2)
+	if !strings.Contains(buf.String(), "Collision detected") {
+		t.Errorf("Expected collision in the engine debug log, got %q", buf.String())
+	}
+
+	// Turning the debug log off discards again
+	SetDebugOutput(nil)
+	buf.Reset()
+	cd.CheckCollision(rect1, rect2)
+	if buf.Len() != 0 {
+		t.Errorf("Expected nothing logged after disabling the debug log, got %q", buf.String())
+	}
+}
+
 func TestCheckCollision_NoCollision(t *testing.T) {
 	cd := NewCollisionDetector()
 
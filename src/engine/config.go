@@ -3,6 +3,8 @@ package engine
 import (
 	"errors"
 	"fmt"
+	"strings"
+	"time"
 )
 
 // Config holds all game configuration parameters
@@ -14,16 +16,53 @@ type Config struct {
 	// Game timing
 	TargetFPS int `json:"target_fps"`
 
-	// Physics constants
-	JumpVelocity  float64 `json:"jump_velocity"`
-	Gravity       float64 `json:"gravity"`
-	ObstacleSpeed float64 `json:"obstacle_speed"`
+	// Physics constants, at sprite scale 1
+	JumpVelocity          float64 `json:"jump_velocity"`
+	Gravity               float64 `json:"gravity"`
+	FallGravityMultiplier float64 `json:"fall_gravity_multiplier"` // Gravity is multiplied by this while falling, for snappier jumps
+	ObstacleSpeed         float64 `json:"obstacle_speed"`
 
 	// Gameplay parameters
-	SpawnRate float64 `json:"spawn_rate"`
+	SpawnRate  float64    `json:"spawn_rate"`
+	Difficulty Difficulty `json:"difficulty"` // Preset that last set ObstacleSpeed and SpawnRate
+
+	// Collision parameters
+	ObstacleHitboxInset float64 `json:"obstacle_hitbox_inset"` // Shrinks obstacle hitboxes inside their sprites on each side
+
+	// Warn when an obstacle will reach the grounded dinosaur within this time (0 disables the warning)
+	WarningThreshold time.Duration `json:"warning_threshold"`
+
+	// Input parameters
+	JumpDebounce time.Duration `json:"jump_debounce"` // Jump presses closer together than this count as one
+	ConfirmQuit  bool          `json:"confirm_quit"`  // Ask before Q or Ctrl+C quits
+	IdleTimeout  time.Duration `json:"idle_timeout"`  // Game over, summary, and settings return to the menu after this long without input (0 disables)
+
+	// Random seed for obstacle and background generation (0 picks a new seed each run)
+	Seed int64 `json:"seed"`
+
+	// Game mode
+	GameMode  GameMode      `json:"game_mode"`
+	TimeLimit time.Duration `json:"time_limit"` // Run length in TimeAttack mode
+
+	// Scoring parameters
+	ScoreTimeMultiplier     int     `json:"score_time_multiplier"`     // Points per second survived
+	ScoreObstacleBonus      int     `json:"score_obstacle_bonus"`      // Bonus points per obstacle passed
+	ScoreDistanceMultiplier float64 `json:"score_distance_multiplier"` // Points per distance unit
 
 	// Rendering options
-	UseUnicode bool `json:"use_unicode"`
+	UseUnicode   bool   `json:"use_unicode"`
+	SpriteScale  int    `json:"sprite_scale"`  // Whole-number magnification of sprites and their hitboxes
+	DinoColor    string `json:"dino_color"`    // Palette color of the dinosaur
+	CactusColor  string `json:"cactus_color"`  // Palette color of cacti
+	BirdColor    string `json:"bird_color"`    // Palette color of birds
+	HighContrast bool   `json:"high_contrast"` // Bold, blocky drawing for low-vision players
+
+	// Palette colors obstacles shift through as their speed climbs, to telegraph
+	// rising difficulty (empty, the default, keeps the cactus and bird colors)
+	ObstacleColorRamp []string `json:"obstacle_color_ramp"`
+
+	// Audio options
+	SoundEnabled bool `json:"sound_enabled"`
 }
 
 // GameState represents the current state of the game
@@ -33,6 +72,13 @@ const (
 	StateMenu GameState = iota
 	StatePlaying
 	StateGameOver
+	StateSettings
+	StateSplash
+	StateSummary
+	StateConfirmQuit
+	StateAttract
+	StateHelp
+	StateLeaderboard
 )
 
 // String returns the string representation of GameState
@@ -44,11 +90,120 @@ func (gs GameState) String() string {
 		return "Playing"
 	case StateGameOver:
 		return "GameOver"
+	case StateSettings:
+		return "Settings"
+	case StateSplash:
+		return "Splash"
+	case StateSummary:
+		return "Summary"
+	case StateConfirmQuit:
+		return "ConfirmQuit"
+	case StateAttract:
+		return "Attract"
+	case StateHelp:
+		return "Help"
+	case StateLeaderboard:
+		return "Leaderboard"
 	default:
 		return "Unknown"
 	}
 }
 
+// GameMode represents how a run ends
+type GameMode int
+
+const (
+	ModeEndless GameMode = iota
+	ModeTimeAttack
+)
+
+// String returns the string representation of GameMode
+func (gm GameMode) String() string {
+	switch gm {
+	case ModeEndless:
+		return "Endless"
+	case ModeTimeAttack:
+		return "TimeAttack"
+	default:
+		return "Unknown"
+	}
+}
+
+// Difficulty is a preset for obstacle speed and spawn rate
+type Difficulty int
+
+const (
+	DifficultyNormal Difficulty = iota
+	DifficultyEasy
+	DifficultyHard
+)
+
+// difficultyPreset holds the config values a difficulty applies
+type difficultyPreset struct {
+	obstacleSpeed float64
+	spawnRate     float64
+}
+
+var difficultyPresets = map[Difficulty]difficultyPreset{
+	DifficultyEasy:   {obstacleSpeed: 14.0, spawnRate: 0.75},
+	DifficultyNormal: {obstacleSpeed: 18.0, spawnRate: 1.0},
+	DifficultyHard:   {obstacleSpeed: 22.0, spawnRate: 1.3},
+}
+
+// String returns the string representation of Difficulty
+func (d Difficulty) String() string {
+	switch d {
+	case DifficultyEasy:
+		return "Easy"
+	case DifficultyNormal:
+		return "Normal"
+	case DifficultyHard:
+		return "Hard"
+	default:
+		return "Unknown"
+	}
+}
+
+// Next returns the following difficulty, cycling Easy -> Normal -> Hard -> Easy
+func (d Difficulty) Next() Difficulty {
+	switch d {
+	case DifficultyEasy:
+		return DifficultyNormal
+	case DifficultyNormal:
+		return DifficultyHard
+	default:
+		return DifficultyEasy
+	}
+}
+
+// ParseDifficulty converts a difficulty name such as "easy" into a Difficulty
+func ParseDifficulty(name string) (Difficulty, error) {
+	for d := range difficultyPresets {
+		if strings.EqualFold(name, d.String()) {
+			return d, nil
+		}
+	}
+	return DifficultyNormal, fmt.Errorf("unknown difficulty %q (want easy, normal, or hard)", name)
+}
+
+// MarshalText encodes the difficulty by name so saved files stay readable
+func (d Difficulty) MarshalText() ([]byte, error) {
+	if _, ok := difficultyPresets[d]; !ok {
+		return nil, fmt.Errorf("unknown difficulty %d", int(d))
+	}
+	return []byte(strings.ToLower(d.String())), nil
+}
+
+// UnmarshalText decodes a difficulty name
+func (d *Difficulty) UnmarshalText(text []byte) error {
+	parsed, err := ParseDifficulty(string(text))
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
 // Rectangle represents a rectangular collision boundary
 type Rectangle struct {
 	X      float64 `json:"x"`
@@ -67,7 +222,24 @@ func NewDefaultConfig() *Config {
 		Gravity:       60.0,
 		ObstacleSpeed: 18.0,
 		SpawnRate:     1.0, // Reduced from 2.0 - start with 1 obstacle per second
+		Difficulty:    DifficultyNormal,
 		UseUnicode:    true, // Default to Unicode for better visuals
+		SpriteScale:   1,
+		DinoColor:     "default",
+		CactusColor:   "green",
+		BirdColor:     "yellow",
+		SoundEnabled:  true,
+		JumpDebounce:  50 * time.Millisecond,
+		IdleTimeout:   60 * time.Second,
+		GameMode:      ModeEndless,
+		TimeLimit:     60 * time.Second, // Only used in TimeAttack mode
+
+		WarningThreshold:      500 * time.Millisecond,
+		FallGravityMultiplier: 1.0,
+
+		ScoreTimeMultiplier:     10,  // 10 points per second
+		ScoreObstacleBonus:      100, // 100 points per obstacle
+		ScoreDistanceMultiplier: 1.0, // 1 point per distance unit
 	}
 }
 
@@ -88,18 +260,63 @@ func (c *Config) Validate() error {
 	if c.Gravity <= 0 {
 		return errors.New("gravity must be positive")
 	}
+	if c.FallGravityMultiplier < 0 {
+		return errors.New("fall gravity multiplier must not be negative")
+	}
 	if c.ObstacleSpeed <= 0 {
 		return errors.New("obstacle speed must be positive")
 	}
 	if c.SpawnRate <= 0 {
 		return errors.New("spawn rate must be positive")
 	}
+	if _, ok := difficultyPresets[c.Difficulty]; !ok {
+		return errors.New("unknown difficulty")
+	}
+	if c.SpriteScale < 0 || c.SpriteScale > MaxSpriteScale { // 0 means unscaled
+		return fmt.Errorf("sprite scale must be between 1 and %d", MaxSpriteScale)
+	}
+	for _, color := range []struct{ name, value string }{
+		{"dino", c.DinoColor}, {"cactus", c.CactusColor}, {"bird", c.BirdColor},
+	} {
+		if !IsPaletteColor(color.value) {
+			return fmt.Errorf("unknown %s color %q", color.name, color.value)
+		}
+	}
+	for _, color := range c.ObstacleColorRamp {
+		if !IsPaletteColor(color) {
+			return fmt.Errorf("unknown obstacle color ramp color %q", color)
+		}
+	}
+	if c.ObstacleHitboxInset < 0 {
+		return errors.New("obstacle hitbox inset must not be negative")
+	}
+	if c.WarningThreshold < 0 {
+		return errors.New("warning threshold must not be negative")
+	}
+	if c.JumpDebounce < 0 {
+		return errors.New("jump debounce must not be negative")
+	}
+	if c.IdleTimeout < 0 {
+		return errors.New("idle timeout must not be negative")
+	}
+	if c.GameMode == ModeTimeAttack && c.TimeLimit <= 0 {
+		return errors.New("time limit must be positive in time attack mode")
+	}
+	if c.ScoreTimeMultiplier < 0 {
+		return errors.New("score time multiplier must not be negative")
+	}
+	if c.ScoreObstacleBonus < 0 {
+		return errors.New("score obstacle bonus must not be negative")
+	}
+	if c.ScoreDistanceMultiplier < 0 {
+		return errors.New("score distance multiplier must not be negative")
+	}
 
 	// Additional validation for reasonable ranges
-	if c.ScreenWidth < 40 {
+	if c.ScreenWidth < MinScreenWidth {
 		return errors.New("screen width too small (minimum 40)")
 	}
-	if c.ScreenHeight < 10 {
+	if c.ScreenHeight < MinScreenHeight {
 		return errors.New("screen height too small (minimum 10)")
 	}
 	if c.TargetFPS > 120 {
@@ -109,6 +326,93 @@ func (c *Config) Validate() error {
 	return nil
 }
 
+// PaletteColors are the color names things can be drawn in
+var PaletteColors = []string{
+	"default", "bold", "ash", "grey", "gray", "dark",
+	"red", "green", "yellow", "blue", "magenta", "cyan", "white",
+}
+
+// DefaultObstacleColorRamp tints obstacles from green through yellow to red as they speed up
+var DefaultObstacleColorRamp = []string{"green", "yellow", "red"}
+
+// IsPaletteColor reports whether name is a palette color. An empty name means the default color.
+func IsPaletteColor(name string) bool {
+	if name == "" {
+		return true
+	}
+	for _, color := range PaletteColors {
+		if color == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Smallest terminal the game can be played in
+const (
+	MinScreenWidth  = 40
+	MinScreenHeight = 10
+)
+
+// ErrScreenTooSmall is returned when the terminal is smaller than the game needs
+var ErrScreenTooSmall = fmt.Errorf("please resize your terminal to at least %dx%d", MinScreenWidth, MinScreenHeight)
+
+// CheckScreenSize reports whether a terminal of the given size can fit the game
+func CheckScreenSize(width, height int) error {
+	if width < MinScreenWidth || height < MinScreenHeight {
+		return fmt.Errorf("%w (currently %dx%d)", ErrScreenTooSmall, width, height)
+	}
+	return nil
+}
+
+// MaxSpriteScale is the largest supported sprite magnification
+const MaxSpriteScale = 4
+
+// GetSpriteScale returns the sprite magnification, treating an unset scale as 1
+func (c *Config) GetSpriteScale() int {
+	if c.SpriteScale < 1 {
+		return 1
+	}
+	return c.SpriteScale
+}
+
+// GetJumpVelocity returns the jump velocity magnified with the sprites, so jumps
+// clear larger obstacles with the same timing
+func (c *Config) GetJumpVelocity() float64 {
+	return c.JumpVelocity * float64(c.GetSpriteScale())
+}
+
+// GetGravity returns gravity magnified with the sprites
+func (c *Config) GetGravity() float64 {
+	return c.Gravity * float64(c.GetSpriteScale())
+}
+
+// GetFallGravity returns the gravity applied while falling, treating an unset
+// multiplier as 1
+func (c *Config) GetFallGravity() float64 {
+	if c.FallGravityMultiplier <= 0 {
+		return c.GetGravity()
+	}
+	return c.GetGravity() * c.FallGravityMultiplier
+}
+
+// GetObstacleSpeed returns the obstacle speed magnified with the sprites, since
+// larger sprites need faster scrolling
+func (c *Config) GetObstacleSpeed() float64 {
+	return c.ObstacleSpeed * float64(c.GetSpriteScale())
+}
+
+// ApplyDifficulty sets the obstacle speed and spawn rate from a difficulty preset
+func (c *Config) ApplyDifficulty(d Difficulty) {
+	preset, ok := difficultyPresets[d]
+	if !ok {
+		return
+	}
+	c.Difficulty = d
+	c.ObstacleSpeed = preset.obstacleSpeed
+	c.SpawnRate = preset.spawnRate
+}
+
 // String returns a formatted string representation of the config
 func (c *Config) String() string {
 	return fmt.Sprintf("Config{Screen: %dx%d, FPS: %d, Jump: %.1f, Gravity: %.1f, Speed: %.1f, Spawn: %.1f}",
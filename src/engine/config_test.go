@@ -1,7 +1,10 @@
 package engine
 
 import (
+	"errors"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestNewDefaultConfig(t *testing.T) {
@@ -36,6 +39,21 @@ func TestNewDefaultConfig(t *testing.T) {
 	if config.UseUnicode != true {
 		t.Errorf("Expected UseUnicode true, got %t", config.UseUnicode)
 	}
+	if config.JumpDebounce != 50*time.Millisecond {
+		t.Errorf("Expected JumpDebounce 50ms, got %v", config.JumpDebounce)
+	}
+	if config.ScoreTimeMultiplier != 10 {
+		t.Errorf("Expected ScoreTimeMultiplier 10, got %d", config.ScoreTimeMultiplier)
+	}
+	if config.ScoreObstacleBonus != 100 {
+		t.Errorf("Expected ScoreObstacleBonus 100, got %d", config.ScoreObstacleBonus)
+	}
+	if config.ScoreDistanceMultiplier != 1.0 {
+		t.Errorf("Expected ScoreDistanceMultiplier 1.0, got %f", config.ScoreDistanceMultiplier)
+	}
+	if len(config.ObstacleColorRamp) != 0 {
+		t.Errorf("Expected obstacles to keep the cactus and bird colors by default, got ramp %v", config.ObstacleColorRamp)
+	}
 }
 
 func TestConfigValidate(t *testing.T) {
@@ -190,6 +208,131 @@ func TestConfigValidate(t *testing.T) {
 			expectError: true,
 			errorMsg:    "spawn rate must be positive",
 		},
+		{
+			name: "time attack without time limit",
+			config: &Config{
+				ScreenWidth:   80,
+				ScreenHeight:  20,
+				TargetFPS:     30,
+				JumpVelocity:  15.0,
+				Gravity:       50.0,
+				ObstacleSpeed: 20.0,
+				SpawnRate:     2.0,
+				GameMode:      ModeTimeAttack,
+			},
+			expectError: true,
+			errorMsg:    "time limit must be positive in time attack mode",
+		},
+		{
+			name: "unknown difficulty",
+			config: &Config{
+				ScreenWidth:   80,
+				ScreenHeight:  20,
+				TargetFPS:     30,
+				JumpVelocity:  15.0,
+				Gravity:       50.0,
+				ObstacleSpeed: 20.0,
+				SpawnRate:     2.0,
+				Difficulty:    Difficulty(999),
+			},
+			expectError: true,
+			errorMsg:    "unknown difficulty",
+		},
+		{
+			name: "negative obstacle hitbox inset",
+			config: &Config{
+				ScreenWidth:         80,
+				ScreenHeight:        20,
+				TargetFPS:           30,
+				JumpVelocity:        15.0,
+				Gravity:             50.0,
+				ObstacleSpeed:       20.0,
+				SpawnRate:           2.0,
+				ObstacleHitboxInset: -0.5,
+			},
+			expectError: true,
+			errorMsg:    "obstacle hitbox inset must not be negative",
+		},
+		{
+			name: "negative warning threshold",
+			config: &Config{
+				ScreenWidth:      80,
+				ScreenHeight:     20,
+				TargetFPS:        30,
+				JumpVelocity:     15.0,
+				Gravity:          50.0,
+				ObstacleSpeed:    20.0,
+				SpawnRate:        2.0,
+				WarningThreshold: -time.Second,
+			},
+			expectError: true,
+			errorMsg:    "warning threshold must not be negative",
+		},
+		{
+			name: "sprite scale too large",
+			config: &Config{
+				ScreenWidth:   80,
+				ScreenHeight:  20,
+				TargetFPS:     30,
+				JumpVelocity:  15.0,
+				Gravity:       50.0,
+				ObstacleSpeed: 20.0,
+				SpawnRate:     2.0,
+				SpriteScale:   MaxSpriteScale + 1,
+			},
+			expectError: true,
+			errorMsg:    "sprite scale must be between 1 and 4",
+		},
+		{
+			name: "negative score obstacle bonus",
+			config: &Config{
+				ScreenWidth:        80,
+				ScreenHeight:       20,
+				TargetFPS:          30,
+				JumpVelocity:       15.0,
+				Gravity:            50.0,
+				ObstacleSpeed:      20.0,
+				SpawnRate:          2.0,
+				ScoreObstacleBonus: -1,
+			},
+			expectError: true,
+			errorMsg:    "score obstacle bonus must not be negative",
+		},
+		{
+			name:        "unknown dino color",
+			config:      func() *Config { c := NewDefaultConfig(); c.DinoColor = "purple"; return c }(),
+			expectError: true,
+			errorMsg:    `unknown dino color "purple"`,
+		},
+		{
+			name:        "unknown cactus color",
+			config:      func() *Config { c := NewDefaultConfig(); c.CactusColor = "Green"; return c }(),
+			expectError: true,
+			errorMsg:    `unknown cactus color "Green"`,
+		},
+		{
+			name:        "unknown bird color",
+			config:      func() *Config { c := NewDefaultConfig(); c.BirdColor = "orange"; return c }(),
+			expectError: true,
+			errorMsg:    `unknown bird color "orange"`,
+		},
+		{
+			name:        "unknown obstacle color ramp color",
+			config:      func() *Config { c := NewDefaultConfig(); c.ObstacleColorRamp = []string{"green", "orange"}; return c }(),
+			expectError: true,
+			errorMsg:    `unknown obstacle color ramp color "orange"`,
+		},
+		{
+			name:        "default obstacle color ramp",
+			config:      func() *Config { c := NewDefaultConfig(); c.ObstacleColorRamp = DefaultObstacleColorRamp; return c }(),
+			expectError: false,
+		},
+		{
+			name:        "negative fall gravity multiplier",
+			config:      func() *Config { c := NewDefaultConfig(); c.FallGravityMultiplier = -1; return c }(),
+			expectError: true,
+			errorMsg:    "fall gravity multiplier must not be negative",
+		},
 	}
 
 	for _, tt := range tests {
@@ -210,6 +353,50 @@ func TestConfigValidate(t *testing.T) {
 	}
 }
 
+func TestIsPaletteColor(t *testing.T) {
+	for _, color := range PaletteColors {
+		if !IsPaletteColor(color) {
+			t.Errorf("Expected %q to be a palette color", color)
+		}
+	}
+	if !IsPaletteColor("") {
+		t.Error("Expected an empty color to mean the default color")
+	}
+	if IsPaletteColor("purple") {
+		t.Error("Expected purple not to be a palette color")
+	}
+}
+
+func TestCheckScreenSize(t *testing.T) {
+	tests := []struct {
+		name    string
+		width   int
+		height  int
+		wantErr bool
+	}{
+		{"exactly minimum", MinScreenWidth, MinScreenHeight, false},
+		{"large terminal", 200, 60, false},
+		{"too narrow", MinScreenWidth - 1, 24, true},
+		{"too short", 80, MinScreenHeight - 1, true},
+		{"empty", 0, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckScreenSize(tt.width, tt.height)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CheckScreenSize(%d, %d) error = %v, wantErr %v", tt.width, tt.height, err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrScreenTooSmall) {
+				t.Errorf("Expected ErrScreenTooSmall, got %v", err)
+			}
+			if err != nil && !strings.Contains(err.Error(), "at least 40x10") {
+				t.Errorf("Expected the minimum size in the message, got %q", err.Error())
+			}
+		})
+	}
+}
+
 func TestGameStateString(t *testing.T) {
 	tests := []struct {
 		state    GameState
@@ -218,6 +405,13 @@ func TestGameStateString(t *testing.T) {
 		{StateMenu, "Menu"},
 		{StatePlaying, "Playing"},
 		{StateGameOver, "GameOver"},
+		{StateSettings, "Settings"},
+		{StateSplash, "Splash"},
+		{StateSummary, "Summary"},
+		{StateConfirmQuit, "ConfirmQuit"},
+		{StateAttract, "Attract"},
+		{StateHelp, "Help"},
+		{StateLeaderboard, "Leaderboard"},
 		{GameState(999), "Unknown"},
 	}
 
@@ -231,6 +425,147 @@ func TestGameStateString(t *testing.T) {
 	}
 }
 
+func TestGameModeString(t *testing.T) {
+	tests := []struct {
+		mode     GameMode
+		expected string
+	}{
+		{ModeEndless, "Endless"},
+		{ModeTimeAttack, "TimeAttack"},
+		{GameMode(999), "Unknown"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			result := tt.mode.String()
+			if result != tt.expected {
+				t.Errorf("Expected %s, got %s", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestDifficultyString(t *testing.T) {
+	tests := []struct {
+		difficulty Difficulty
+		expected   string
+	}{
+		{DifficultyEasy, "Easy"},
+		{DifficultyNormal, "Normal"},
+		{DifficultyHard, "Hard"},
+		{Difficulty(999), "Unknown"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			result := tt.difficulty.String()
+			if result != tt.expected {
+				t.Errorf("Expected %s, got %s", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestDifficultyNextCycles(t *testing.T) {
+	d := DifficultyEasy
+	expected := []Difficulty{DifficultyNormal, DifficultyHard, DifficultyEasy}
+	for _, want := range expected {
+		d = d.Next()
+		if d != want {
+			t.Errorf("Expected %s, got %s", want, d)
+		}
+	}
+}
+
+func TestParseDifficulty(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected Difficulty
+		wantErr  bool
+	}{
+		{"easy", DifficultyEasy, false},
+		{"Normal", DifficultyNormal, false},
+		{"HARD", DifficultyHard, false},
+		{"nightmare", DifficultyNormal, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, err := ParseDifficulty(tt.name)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseDifficulty(%q) error = %v, wantErr %v", tt.name, err, tt.wantErr)
+			}
+			if d != tt.expected {
+				t.Errorf("ParseDifficulty(%q) = %s, want %s", tt.name, d, tt.expected)
+			}
+		})
+	}
+}
+
+func TestConfigScaledPhysics(t *testing.T) {
+	config := NewDefaultConfig()
+	config.SpriteScale = 2
+	config.FallGravityMultiplier = 1.5
+
+	if config.GetJumpVelocity() != 2*config.JumpVelocity {
+		t.Errorf("Expected jump velocity %f, got %f", 2*config.JumpVelocity, config.GetJumpVelocity())
+	}
+	if config.GetGravity() != 2*config.Gravity {
+		t.Errorf("Expected gravity %f, got %f", 2*config.Gravity, config.GetGravity())
+	}
+	if config.GetFallGravity() != 3*config.Gravity {
+		t.Errorf("Expected fall gravity %f, got %f", 3*config.Gravity, config.GetFallGravity())
+	}
+	if config.GetObstacleSpeed() != 2*config.ObstacleSpeed {
+		t.Errorf("Expected obstacle speed %f, got %f", 2*config.ObstacleSpeed, config.GetObstacleSpeed())
+	}
+
+	// Difficulty presets set the unscaled speed, however often they are applied
+	config.ApplyDifficulty(DifficultyHard)
+	config.ApplyDifficulty(DifficultyHard)
+	hard := NewDefaultConfig()
+	hard.ApplyDifficulty(DifficultyHard)
+	if config.ObstacleSpeed != hard.ObstacleSpeed {
+		t.Errorf("Expected the stored speed %f to stay unscaled, got %f", hard.ObstacleSpeed, config.ObstacleSpeed)
+	}
+	if config.GetObstacleSpeed() != 2*hard.ObstacleSpeed {
+		t.Errorf("Expected the scaled speed %f, got %f", 2*hard.ObstacleSpeed, config.GetObstacleSpeed())
+	}
+}
+
+func TestConfigApplyDifficulty(t *testing.T) {
+	config := NewDefaultConfig()
+
+	config.ApplyDifficulty(DifficultyHard)
+	if config.Difficulty != DifficultyHard {
+		t.Errorf("Expected difficulty Hard, got %s", config.Difficulty)
+	}
+	if config.ObstacleSpeed <= NewDefaultConfig().ObstacleSpeed {
+		t.Errorf("Hard should be faster than the default, got speed %.1f", config.ObstacleSpeed)
+	}
+
+	config.ApplyDifficulty(DifficultyEasy)
+	if config.SpawnRate >= NewDefaultConfig().SpawnRate {
+		t.Errorf("Easy should spawn less often than the default, got rate %.2f", config.SpawnRate)
+	}
+	if err := config.Validate(); err != nil {
+		t.Errorf("Config should stay valid after applying a difficulty: %v", err)
+	}
+
+	// Normal matches the defaults
+	config.ApplyDifficulty(DifficultyNormal)
+	defaults := NewDefaultConfig()
+	if config.ObstacleSpeed != defaults.ObstacleSpeed || config.SpawnRate != defaults.SpawnRate {
+		t.Errorf("Normal should match the default speed and spawn rate, got %s", config)
+	}
+
+	// Unknown difficulties leave the config unchanged
+	config.ApplyDifficulty(Difficulty(999))
+	if config.Difficulty != DifficultyNormal {
+		t.Errorf("Unknown difficulty should be ignored, got %s", config.Difficulty)
+	}
+}
+
 func TestConfigString(t *testing.T) {
 	config := NewDefaultConfig()
 	result := config.String()
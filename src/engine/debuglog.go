@@ -0,0 +1,42 @@
+package engine
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+
+	"cli-dino-game/src/score"
+)
+
+// debugLogger receives engine debug output. It discards everything until
+// SetDebugOutput is called, so nothing is printed over the game screen.
+var debugLogger = log.New(io.Discard, "", log.Ltime|log.Lmicroseconds)
+
+// SetDebugOutput sets where engine debug logs are written. A nil writer discards them.
+func SetDebugOutput(w io.Writer) {
+	if w == nil {
+		w = io.Discard
+	}
+	debugLogger.SetOutput(w)
+}
+
+// OpenDebugLog opens the debug log file in the score directory for appending
+func OpenDebugLog() (*os.File, error) {
+	dataDir, err := score.GetDataDir()
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.OpenFile(filepath.Join(dataDir, "debug.log"), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open debug log: %w", err)
+	}
+	return file, nil
+}
+
+// debugf writes a line to the debug log
+func debugf(format string, args ...interface{}) {
+	debugLogger.Printf(format, args...)
+}
@@ -0,0 +1,33 @@
+package engine
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestOpenDebugLog(t *testing.T) {
+	tempDir := t.TempDir()
+	originalHome := os.Getenv("HOME")
+	os.Setenv("HOME", tempDir)
+	defer os.Setenv("HOME", originalHome)
+	defer SetDebugOutput(nil)
+
+	file, err := OpenDebugLog()
+	if err != nil {
+		t.Fatalf("Failed to open debug log: %v", err)
+	}
+
+	SetDebugOutput(file)
+	debugf("hello %d", 42)
+	file.Close()
+
+	data, err := os.ReadFile(filepath.Join(tempDir, ".cli-dino-game", "debug.log"))
+	if err != nil {
+		t.Fatalf("Failed to read debug log: %v", err)
+	}
+	if !strings.Contains(string(data), "hello 42") {
+		t.Errorf("Expected debug line in the log file, got %q", string(data))
+	}
+}
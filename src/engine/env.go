@@ -0,0 +1,57 @@
+package engine
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+)
+
+// Environment variables that override the config, for containers and CI runs
+// where flags are awkward to pass. Command line flags still take precedence.
+const (
+	EnvFPS        = "DINO_FPS"
+	EnvDifficulty = "DINO_DIFFICULTY"
+	EnvUnicode    = "DINO_UNICODE"
+	EnvSeed       = "DINO_SEED"
+)
+
+// ApplyEnvOverrides sets config values from the DINO_* environment variables and
+// validates the result. Unset or empty variables leave the config unchanged.
+func ApplyEnvOverrides(config *Config) error {
+	if value := os.Getenv(EnvFPS); value != "" {
+		fps, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf("%s must be a whole number, got %q", EnvFPS, value)
+		}
+		config.TargetFPS = fps
+	}
+
+	if value := os.Getenv(EnvDifficulty); value != "" {
+		difficulty, err := ParseDifficulty(value)
+		if err != nil {
+			return fmt.Errorf("%s: %w", EnvDifficulty, err)
+		}
+		config.ApplyDifficulty(difficulty)
+	}
+
+	if value := os.Getenv(EnvUnicode); value != "" {
+		useUnicode, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("%s must be true or false, got %q", EnvUnicode, value)
+		}
+		config.UseUnicode = useUnicode
+	}
+
+	if value := os.Getenv(EnvSeed); value != "" {
+		seed, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return fmt.Errorf("%s must be a whole number, got %q", EnvSeed, value)
+		}
+		config.Seed = seed
+	}
+
+	if err := config.Validate(); err != nil {
+		return fmt.Errorf("invalid config from environment: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package engine
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestApplyEnvOverrides(t *testing.T) {
+	t.Setenv(EnvFPS, "30")
+	t.Setenv(EnvDifficulty, "hard")
+	t.Setenv(EnvUnicode, "false")
+	t.Setenv(EnvSeed, "42")
+
+	config := NewDefaultConfig()
+	if err := ApplyEnvOverrides(config); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if config.TargetFPS != 30 {
+		t.Errorf("Expected FPS 30, got %d", config.TargetFPS)
+	}
+	if config.Difficulty != DifficultyHard {
+		t.Errorf("Expected hard difficulty, got %s", config.Difficulty)
+	}
+	hard := NewDefaultConfig()
+	hard.ApplyDifficulty(DifficultyHard)
+	if config.ObstacleSpeed != hard.ObstacleSpeed || config.SpawnRate != hard.SpawnRate {
+		t.Error("Expected the hard preset's speed and spawn rate")
+	}
+	if config.UseUnicode {
+		t.Error("Expected Unicode to be turned off")
+	}
+	if config.Seed != 42 {
+		t.Errorf("Expected seed 42, got %d", config.Seed)
+	}
+}
+
+func TestApplyEnvOverridesUnset(t *testing.T) {
+	for _, name := range []string{EnvFPS, EnvDifficulty, EnvUnicode, EnvSeed} {
+		t.Setenv(name, "")
+	}
+
+	config := NewDefaultConfig()
+	if err := ApplyEnvOverrides(config); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(config, NewDefaultConfig()) {
+		t.Errorf("Expected unset variables to leave the config alone, got %+v", config)
+	}
+}
+
+func TestApplyEnvOverridesInvalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		wantErr string
+	}{
+		{EnvFPS, "fast", "DINO_FPS must be a whole number"},
+		{EnvFPS, "500", "target FPS too high"},
+		{EnvFPS, "0", "target FPS must be positive"},
+		{EnvDifficulty, "brutal", "unknown difficulty"},
+		{EnvUnicode, "maybe", "DINO_UNICODE must be true or false"},
+		{EnvSeed, "1.5", "DINO_SEED must be a whole number"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name+"="+tt.value, func(t *testing.T) {
+			t.Setenv(tt.name, tt.value)
+
+			err := ApplyEnvOverrides(NewDefaultConfig())
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Expected an error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
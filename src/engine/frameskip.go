@@ -0,0 +1,79 @@
+package engine
+
+import "time"
+
+// DefaultMaxFrameSkip is how many frames in a row may go undrawn to catch up
+const DefaultMaxFrameSkip = 2
+
+// FrameSkipper tracks how far the game loop has fallen behind its frame rate
+// and decides when to skip drawing a frame so updates can catch up. Updates
+// are never skipped, only rendering.
+type FrameSkipper struct {
+	frameDuration time.Duration
+	maxSkip       int
+
+	lag     time.Duration // Time the loop is behind schedule
+	skipped int           // Frames skipped in a row
+}
+
+// NewFrameSkipper creates a frame skipper for frames of the given duration
+func NewFrameSkipper(frameDuration time.Duration) *FrameSkipper {
+	return &FrameSkipper{
+		frameDuration: frameDuration,
+		maxSkip:       DefaultMaxFrameSkip,
+	}
+}
+
+// SetMaxFrameSkip sets how many frames in a row may be skipped; 0 always draws
+func (fs *FrameSkipper) SetMaxFrameSkip(n int) {
+	if n < 0 {
+		n = 0
+	}
+	fs.maxSkip = n
+}
+
+// GetMaxFrameSkip returns how many frames in a row may be skipped
+func (fs *FrameSkipper) GetMaxFrameSkip() int {
+	return fs.maxSkip
+}
+
+// RecordFrame adds how long a frame's update and render took. Time over the
+// frame duration adds to the lag and time under it pays the lag back.
+func (fs *FrameSkipper) RecordFrame(elapsed time.Duration) {
+	fs.lag += elapsed - fs.frameDuration
+	if fs.lag < 0 {
+		fs.lag = 0
+	}
+}
+
+// ShouldRender reports whether this frame should be drawn. A frame is skipped
+// while the loop is at least a frame behind, up to the skip limit. Lag still
+// left at the limit is forgiven, so a machine that can never keep up doesn't
+// stop drawing altogether.
+func (fs *FrameSkipper) ShouldRender() bool {
+	if fs.lag < fs.frameDuration {
+		fs.skipped = 0
+		return true
+	}
+
+	if fs.skipped >= fs.maxSkip {
+		fs.skipped = 0
+		fs.lag = 0
+		return true
+	}
+
+	fs.skipped++
+	fs.lag -= fs.frameDuration
+	return false
+}
+
+// GetLag returns how far behind schedule the loop is
+func (fs *FrameSkipper) GetLag() time.Duration {
+	return fs.lag
+}
+
+// Reset forgets any lag, for when time passed without the loop running
+func (fs *FrameSkipper) Reset() {
+	fs.lag = 0
+	fs.skipped = 0
+}
@@ -0,0 +1,99 @@
+package engine
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFrameSkipperOnSchedule(t *testing.T) {
+	fs := NewFrameSkipper(10 * time.Millisecond)
+
+	for i := 0; i < 5; i++ {
+		if !fs.ShouldRender() {
+			t.Fatalf("Frame %d: expected to render while on schedule", i)
+		}
+		fs.RecordFrame(8 * time.Millisecond)
+	}
+	if fs.GetLag() != 0 {
+		t.Errorf("Expected no lag for fast frames, got %v", fs.GetLag())
+	}
+}
+
+func TestFrameSkipperCatchUp(t *testing.T) {
+	frame := 10 * time.Millisecond
+	fs := NewFrameSkipper(frame)
+
+	// One slow frame puts the loop 15ms behind
+	fs.RecordFrame(25 * time.Millisecond)
+	if fs.GetLag() != 15*time.Millisecond {
+		t.Fatalf("Expected 15ms lag, got %v", fs.GetLag())
+	}
+
+	// A frame is skipped to pay back a frame's worth of lag
+	if fs.ShouldRender() {
+		t.Error("Expected to skip rendering while a frame behind")
+	}
+	if fs.GetLag() != 5*time.Millisecond {
+		t.Errorf("Expected 5ms lag after skipping, got %v", fs.GetLag())
+	}
+
+	// Less than a frame behind, so the next one is drawn
+	if !fs.ShouldRender() {
+		t.Error("Expected to render once less than a frame behind")
+	}
+
+	// Quick frames pay back the rest
+	fs.RecordFrame(2 * time.Millisecond)
+	if fs.GetLag() != 0 {
+		t.Errorf("Expected lag to be paid back, got %v", fs.GetLag())
+	}
+}
+
+func TestFrameSkipperCapsSkips(t *testing.T) {
+	frame := 10 * time.Millisecond
+	fs := NewFrameSkipper(frame)
+	fs.SetMaxFrameSkip(2)
+
+	// Far behind: only two frames are skipped before one is drawn
+	fs.RecordFrame(100 * time.Millisecond)
+	rendered := []bool{fs.ShouldRender(), fs.ShouldRender(), fs.ShouldRender()}
+	want := []bool{false, false, true}
+	for i := range want {
+		if rendered[i] != want[i] {
+			t.Errorf("Frame %d: expected render=%v, got %v", i, want[i], rendered[i])
+		}
+	}
+
+	// The leftover lag is forgiven instead of spiralling
+	if fs.GetLag() != 0 {
+		t.Errorf("Expected lag forgiven at the skip limit, got %v", fs.GetLag())
+	}
+}
+
+func TestFrameSkipperSetMaxFrameSkip(t *testing.T) {
+	fs := NewFrameSkipper(10 * time.Millisecond)
+	if fs.GetMaxFrameSkip() != DefaultMaxFrameSkip {
+		t.Errorf("Expected default max frame skip %d, got %d", DefaultMaxFrameSkip, fs.GetMaxFrameSkip())
+	}
+
+	fs.SetMaxFrameSkip(-1)
+	if fs.GetMaxFrameSkip() != 0 {
+		t.Errorf("Expected negative max frame skip to clamp to 0, got %d", fs.GetMaxFrameSkip())
+	}
+
+	// With skipping off every frame is drawn
+	fs.RecordFrame(time.Second)
+	if !fs.ShouldRender() {
+		t.Error("Expected to render with frame skipping off")
+	}
+}
+
+func TestFrameSkipperReset(t *testing.T) {
+	fs := NewFrameSkipper(10 * time.Millisecond)
+	fs.RecordFrame(time.Second)
+
+	fs.Reset()
+	if fs.GetLag() != 0 || !fs.ShouldRender() {
+		t.Error("Expected Reset to forget the lag")
+	}
+}
@@ -1,6 +1,7 @@
 package engine
 
 import (
+	"cli-dino-game/src/clock"
 	"cli-dino-game/src/score"
 	"time"
 )
@@ -14,6 +15,7 @@ type GameEngine struct {
 	gameOver      bool
 	startTime     time.Time
 	initialized   bool
+	seed          int64 // RNG seed for the current or upcoming run
 
 	// Configuration
 	config *Config
@@ -24,22 +26,44 @@ type GameEngine struct {
 	// Collision detection
 	collisionDetector  *CollisionDetector
 	collisionTolerance float64 // For more forgiving gameplay
+	godMode            bool    // Obstacles never end the run
 
 	// Game timing
+	clock      clock.Clock          // Time source for frame timing
+	runClock   *clock.PausableClock // Follows clock, but stops while the run is paused
 	lastUpdate time.Time
 	deltaTime  float64
 
+	// Single-frame stepping for debugging: while stepping, the simulation only
+	// advances when a step is queued, and then by exactly one frame
+	stepping    bool
+	stepPending bool
+
+	// Outstanding HoldRun calls, each keeping the run clock stopped
+	holds int
+
+	// Last input or screen change, for returning idle screens to the menu
+	lastActivity time.Time
+
 	// State transition callbacks
 	onStateChange func(from, to GameState)
 }
 
 // NewGameEngine creates a new game engine with the specified configuration
 func NewGameEngine(config *Config) *GameEngine {
-	gameScore := score.NewScore()
+	gameScore := score.NewScoreWithConfig(
+		config.ScoreTimeMultiplier,
+		config.ScoreObstacleBonus,
+		config.ScoreDistanceMultiplier,
+	)
 	// Load high score from persistent storage
 	gameScore.LoadHighScoreInto()
 
-	return &GameEngine{
+	// Run duration and time points stop while the run is paused
+	runClock := clock.NewPausableClock(clock.Real)
+	gameScore.SetClock(runClock)
+
+	ge := &GameEngine{
 		state:              StateMenu,
 		previousState:      StateMenu,
 		running:            false,
@@ -49,8 +73,33 @@ func NewGameEngine(config *Config) *GameEngine {
 		gameScore:          gameScore,
 		collisionDetector:  NewCollisionDetector(),
 		collisionTolerance: 0.8, // Balanced tolerance - forgiving for cacti but still detects birds
-		lastUpdate:         time.Now(),
+		clock:              clock.Real,
+		runClock:           runClock,
+		lastUpdate:         clock.Real.Now(),
+		lastActivity:       clock.Real.Now(),
+	}
+	ge.seed = ge.chooseSeed()
+
+	return ge
+}
+
+// chooseSeed returns the configured seed, or a fresh time-based seed if none is set
+func (ge *GameEngine) chooseSeed() int64 {
+	if ge.config.Seed != 0 {
+		return ge.config.Seed
 	}
+	return time.Now().UnixNano()
+}
+
+// GetSeed returns the RNG seed for the current or upcoming run
+func (ge *GameEngine) GetSeed() int64 {
+	return ge.seed
+}
+
+// SetSeed fixes the RNG seed used for every subsequent run
+func (ge *GameEngine) SetSeed(seed int64) {
+	ge.config.Seed = seed
+	ge.seed = ge.chooseSeed()
 }
 
 // GetState returns the current game state
@@ -67,6 +116,7 @@ func (ge *GameEngine) SetState(state GameState) {
 	previousState := ge.state
 	ge.previousState = previousState
 	ge.state = state
+	ge.lastActivity = ge.clock.Now()
 
 	// Handle state-specific logic
 	ge.handleStateTransition(previousState, state)
@@ -89,10 +139,26 @@ func (ge *GameEngine) SetStateChangeCallback(callback func(from, to GameState))
 
 // handleStateTransition handles logic when transitioning between states
 func (ge *GameEngine) handleStateTransition(from, to GameState) {
+	// The run clock stops while the quit prompt is open over a run
+	if to == StateConfirmQuit && from == StatePlaying {
+		ge.runClock.Pause()
+	}
+
+	// Backing out of the quit prompt resumes the earlier state untouched
+	if from == StateConfirmQuit {
+		if to == StatePlaying {
+			ge.resumeRunClock()
+		}
+		return
+	}
+
 	switch to {
 	case StateMenu:
 		ge.running = false
 		ge.gameOver = false
+		ge.SetStepping(false)
+		// The next run started from the menu gets its own seed
+		ge.seed = ge.chooseSeed()
 	case StatePlaying:
 		if !ge.initialized {
 			ge.initialize()
@@ -100,12 +166,13 @@ func (ge *GameEngine) handleStateTransition(from, to GameState) {
 		ge.running = true
 		ge.gameOver = false
 		if from != StatePlaying {
-			ge.startTime = time.Now()
+			ge.startTime = ge.runClock.Now()
 			ge.ResetScore() // Reset score when starting a new game
 		}
 	case StateGameOver:
 		ge.running = false
 		ge.gameOver = true
+		ge.SetStepping(false)
 		// Finalize score when game ends
 		if ge.gameScore != nil {
 			ge.FinalizeScore()
@@ -126,7 +193,7 @@ func (ge *GameEngine) IsGameOver() bool {
 // Initialize initializes the game engine for gameplay
 func (ge *GameEngine) initialize() {
 	ge.initialized = true
-	ge.lastUpdate = time.Now()
+	ge.lastUpdate = ge.clock.Now()
 	// Additional initialization logic can be added here
 }
 
@@ -158,18 +225,156 @@ func (ge *GameEngine) Cleanup() {
 func (ge *GameEngine) Reset() {
 	ge.SetState(StateMenu)
 	ge.startTime = time.Time{}
-	ge.lastUpdate = time.Now()
+	ge.lastUpdate = ge.clock.Now()
 	ge.initialized = false
+	ge.seed = ge.chooseSeed()
 }
 
-// Update updates the game engine timing and score
+// Update updates the game engine timing and score using the time measured since the last update
 func (ge *GameEngine) Update() {
-	now := time.Now()
-	ge.deltaTime = now.Sub(ge.lastUpdate).Seconds()
-	ge.lastUpdate = now
+	deltaTime := ge.clock.Now().Sub(ge.lastUpdate).Seconds()
+
+	// A step always covers exactly one frame, however long the pause before it,
+	// and moves the stopped run clock on by that frame
+	if ge.stepping {
+		deltaTime = 1.0 / float64(ge.config.TargetFPS)
+		ge.runClock.Advance(time.Duration(deltaTime * float64(time.Second)))
+	}
+
+	ge.UpdateWithDelta(deltaTime)
+}
+
+// UpdateWithDelta updates the game engine timing and score as if deltaTime seconds
+// had passed, for deterministic simulation such as tests and replays
+func (ge *GameEngine) UpdateWithDelta(deltaTime float64) {
+	ge.deltaTime = deltaTime
+	ge.lastUpdate = ge.clock.Now()
 
 	// Update score if game is playing
 	ge.UpdateScore()
+
+	// End the run once the time limit is reached in time attack mode
+	if ge.state == StatePlaying && ge.IsTimeUp() {
+		ge.TriggerGameOver()
+	}
+
+	// Screens left alone for too long go back to the menu
+	if ge.isIdleScreen() && ge.config.IdleTimeout > 0 && ge.GetIdleTime() >= ge.config.IdleTimeout {
+		ge.TransitionTo(StateMenu)
+	}
+}
+
+// isIdleScreen reports whether the current state is a screen the idle timeout
+// returns to the menu from. The menu has its own demo, and runs are never idle.
+func (ge *GameEngine) isIdleScreen() bool {
+	switch ge.state {
+	case StateGameOver, StateSummary, StateSettings, StateHelp, StateLeaderboard:
+		return true
+	default:
+		return false
+	}
+}
+
+// RecordInput notes that the player pressed a key, restarting the idle timer
+func (ge *GameEngine) RecordInput() {
+	ge.lastActivity = ge.clock.Now()
+}
+
+// GetIdleTime returns how long it has been since the last input or screen change
+func (ge *GameEngine) GetIdleTime() time.Duration {
+	return ge.clock.Now().Sub(ge.lastActivity)
+}
+
+// ResetFrameClock discards the time since the last update, so resuming after
+// a pause doesn't produce one huge frame
+func (ge *GameEngine) ResetFrameClock() {
+	ge.lastUpdate = ge.clock.Now()
+}
+
+// SetStepping pauses the simulation so it only advances one frame per StepOnce.
+// The run clock stops with it, so the paused time doesn't count towards the run.
+func (ge *GameEngine) SetStepping(stepping bool) {
+	ge.stepping = stepping
+	ge.stepPending = false
+	if stepping {
+		ge.runClock.Pause()
+		return
+	}
+	ge.resumeRunClock()
+	ge.ResetFrameClock()
+}
+
+// HoldRun stops the run clock until a matching ReleaseRun, for pauses from
+// outside the game such as a terminal that's too small or a suspended process
+func (ge *GameEngine) HoldRun() {
+	ge.holds++
+	ge.runClock.Pause()
+}
+
+// ReleaseRun undoes a HoldRun, starting the run clock again once nothing else
+// is keeping it stopped. The held time doesn't count as one long frame.
+func (ge *GameEngine) ReleaseRun() {
+	if ge.holds == 0 {
+		return
+	}
+	ge.holds--
+	ge.resumeRunClock()
+	ge.ResetFrameClock()
+}
+
+// resumeRunClock starts the run clock again unless stepping, a hold, or the
+// quit prompt still keeps it stopped
+func (ge *GameEngine) resumeRunClock() {
+	if ge.stepping || ge.holds > 0 || ge.state == StateConfirmQuit {
+		return
+	}
+	ge.runClock.Resume()
+}
+
+// IsStepping returns whether the simulation is paused for single-frame stepping
+func (ge *GameEngine) IsStepping() bool {
+	return ge.stepping
+}
+
+// StepOnce queues a single frame to be simulated while stepping
+func (ge *GameEngine) StepOnce() {
+	if ge.stepping {
+		ge.stepPending = true
+	}
+}
+
+// ShouldUpdate reports whether the simulation should advance this frame. While
+// stepping it is true once per queued step.
+func (ge *GameEngine) ShouldUpdate() bool {
+	if !ge.stepping {
+		return true
+	}
+	if ge.stepPending {
+		ge.stepPending = false
+		return true
+	}
+	return false
+}
+
+// SetClock sets the time source for frame timing, run duration, and scoring
+func (ge *GameEngine) SetClock(c clock.Clock) {
+	ge.clock = c
+	ge.runClock = clock.NewPausableClock(c)
+	ge.lastUpdate = c.Now()
+	ge.lastActivity = c.Now()
+	if ge.gameScore != nil {
+		ge.gameScore.SetClock(ge.runClock)
+	}
+}
+
+// GetClock returns the time source the engine runs on
+func (ge *GameEngine) GetClock() clock.Clock {
+	return ge.clock
+}
+
+// GetRunClock returns the time source for the run, which stops while the run is paused
+func (ge *GameEngine) GetRunClock() clock.Clock {
+	return ge.runClock
 }
 
 // GetDeltaTime returns the time elapsed since the last update
@@ -192,6 +397,17 @@ func (ge *GameEngine) GetCollisionTolerance() float64 {
 	return ge.collisionTolerance
 }
 
+// SetGodMode turns collisions with obstacles off, so runs never end but still
+// score for the obstacles passed
+func (ge *GameEngine) SetGodMode(enabled bool) {
+	ge.godMode = enabled
+}
+
+// IsGodMode returns whether collisions with obstacles are turned off
+func (ge *GameEngine) IsGodMode() bool {
+	return ge.godMode
+}
+
 // EnableCollisionDebug enables debug mode for collision detection
 func (ge *GameEngine) EnableCollisionDebug(enabled bool) {
 	ge.collisionDetector.SetDebugMode(enabled)
@@ -205,6 +421,50 @@ func (ge *GameEngine) CheckCollision(rect1, rect2 Rectangle) bool {
 	return ge.collisionDetector.CheckCollision(rect1, rect2)
 }
 
+// AnyCollision reports whether the dinosaur collides with any of the obstacles,
+// stopping at the first hit
+func (ge *GameEngine) AnyCollision(dino Rectangle, obstacles []Rectangle) bool {
+	for _, obstacle := range obstacles {
+		if ge.CheckCollision(dino, obstacle) {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckEntityCollision checks two entities for a collision, ignoring inactive ones
+func (ge *GameEngine) CheckEntityCollision(a, b Collidable) bool {
+	if !a.IsActive() || !b.IsActive() {
+		return false
+	}
+	return ge.CheckCollision(a.GetBounds(), b.GetBounds())
+}
+
+// ProcessCollisions checks the dinosaur against the active obstacles. It reports
+// whether any obstacle was hit and, if none was, returns the unscored obstacles
+// whose right edge is now left of passedX. Obstacles that have left the screen
+// are deactivated. In god mode nothing is ever hit.
+func (ge *GameEngine) ProcessCollisions(dino Rectangle, obstacles []Scorable, passedX float64) (hit bool, passed []Scorable) {
+	for _, obstacle := range obstacles {
+		if !obstacle.IsActive() {
+			continue
+		}
+
+		bounds := obstacle.GetBounds()
+		if bounds.X+bounds.Width < 0 {
+			obstacle.Deactivate()
+			continue
+		}
+		if !ge.godMode && ge.CheckCollision(dino, bounds) {
+			return true, nil
+		}
+		if !obstacle.IsScored() && bounds.X+bounds.Width < passedX {
+			passed = append(passed, obstacle)
+		}
+	}
+	return false, passed
+}
+
 // GetCollisionInfo returns detailed collision information
 func (ge *GameEngine) GetCollisionInfo(rect1, rect2 Rectangle) CollisionInfo {
 	return ge.collisionDetector.GetCollisionInfo(rect1, rect2)
@@ -215,12 +475,34 @@ func (ge *GameEngine) TriggerGameOver() {
 	ge.SetState(StateGameOver)
 }
 
-// GetGameDuration returns how long the current game has been running
+// GetGameDuration returns how long the current game has been running, not
+// counting time it was paused
 func (ge *GameEngine) GetGameDuration() time.Duration {
 	if ge.startTime.IsZero() {
 		return 0
 	}
-	return time.Since(ge.startTime)
+	return ge.runClock.Now().Sub(ge.startTime)
+}
+
+// GetTimeRemaining returns the time left in the current run for time attack mode.
+// It returns 0 in endless mode.
+func (ge *GameEngine) GetTimeRemaining() time.Duration {
+	if ge.config.GameMode != ModeTimeAttack {
+		return 0
+	}
+	remaining := ge.config.TimeLimit - ge.GetGameDuration()
+	if remaining < 0 {
+		return 0
+	}
+	return remaining
+}
+
+// IsTimeUp returns whether the time limit has been reached in time attack mode
+func (ge *GameEngine) IsTimeUp() bool {
+	if ge.config.GameMode != ModeTimeAttack || ge.startTime.IsZero() {
+		return false
+	}
+	return ge.GetGameDuration() >= ge.config.TimeLimit
 }
 
 // Restart restarts the game from game over state
@@ -231,15 +513,40 @@ func (ge *GameEngine) Restart() {
 	}
 }
 
+// RestartOnSeed restarts the game from game over state like Restart, but plays
+// the new run on the given seed instead of choosing one
+func (ge *GameEngine) RestartOnSeed(seed int64) {
+	if ge.state == StateGameOver {
+		ge.Reset()
+		ge.seed = seed
+		ge.Start()
+	}
+}
+
 // CanTransitionTo checks if a state transition is valid
 func (ge *GameEngine) CanTransitionTo(newState GameState) bool {
+	// The quit prompt can open over any screen and only returns to that screen
+	if newState == StateConfirmQuit {
+		return ge.state != StateConfirmQuit
+	}
+
 	switch ge.state {
 	case StateMenu:
-		return newState == StatePlaying
+		return newState == StatePlaying || newState == StateSettings || newState == StateAttract || newState == StateHelp || newState == StateLeaderboard
+	case StateSettings, StateHelp, StateLeaderboard:
+		return newState == StateMenu
+	case StateSplash:
+		return newState == StateMenu
 	case StatePlaying:
 		return newState == StateGameOver || newState == StateMenu
 	case StateGameOver:
-		return newState == StateMenu || newState == StatePlaying
+		return newState == StateMenu || newState == StatePlaying || newState == StateSummary
+	case StateSummary:
+		return newState == StateMenu
+	case StateAttract:
+		return newState == StatePlaying || newState == StateMenu
+	case StateConfirmQuit:
+		return newState == ge.previousState
 	default:
 		return false
 	}
@@ -266,6 +573,13 @@ func (ge *GameEngine) UpdateScore() {
 	}
 }
 
+// SetWorldSpeed sets how fast the world scrolls, so score distance matches how far obstacles move
+func (ge *GameEngine) SetWorldSpeed(speed float64) {
+	if ge.gameScore != nil {
+		ge.gameScore.SetDistanceRate(speed)
+	}
+}
+
 // AddObstacleBonus adds bonus points for passing an obstacle
 func (ge *GameEngine) AddObstacleBonus() {
 	if ge.gameScore != nil {
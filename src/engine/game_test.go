@@ -1,10 +1,20 @@
 package engine
 
 import (
+	"cli-dino-game/src/clock"
+	"math"
 	"testing"
 	"time"
 )
 
+// newFakeClockEngine creates an engine whose time only moves when the returned clock is advanced
+func newFakeClockEngine(config *Config) (*GameEngine, *clock.FakeClock) {
+	ge := NewGameEngine(config)
+	fake := clock.NewFakeClock(time.Now())
+	ge.SetClock(fake)
+	return ge, fake
+}
+
 func TestNewGameEngine(t *testing.T) {
 	config := NewDefaultConfig()
 	ge := NewGameEngine(config)
@@ -117,21 +127,19 @@ func TestGameEngineReset(t *testing.T) {
 
 func TestGameEngineUpdate(t *testing.T) {
 	config := NewDefaultConfig()
-	ge := NewGameEngine(config)
+	ge, fake := newFakeClockEngine(config)
 
 	// Initial delta time should be 0
-	initialDelta := ge.GetDeltaTime()
+	if ge.GetDeltaTime() != 0 {
+		t.Errorf("Expected initial delta time 0, got %f", ge.GetDeltaTime())
+	}
 
-	// Wait a bit and update
-	time.Sleep(10 * time.Millisecond)
+	// Move time on and update
+	fake.Advance(10 * time.Millisecond)
 	ge.Update()
 
-	newDelta := ge.GetDeltaTime()
-	if newDelta <= initialDelta {
-		t.Error("Delta time should increase after Update()")
-	}
-	if newDelta <= 0 {
-		t.Error("Delta time should be positive")
+	if ge.GetDeltaTime() != 0.01 {
+		t.Errorf("Expected delta time 0.01 after 10ms, got %f", ge.GetDeltaTime())
 	}
 }
 
@@ -178,6 +186,172 @@ func TestGameEngineCollisionDetection(t *testing.T) {
 	}
 }
 
+func TestGameEngineAnyCollision(t *testing.T) {
+	config := NewDefaultConfig()
+	ge := NewGameEngine(config)
+	ge.SetCollisionTolerance(0)
+
+	dino := Rectangle{X: 0, Y: 0, Width: 10, Height: 10}
+	clear := Rectangle{X: 20, Y: 0, Width: 5, Height: 5}
+	hit := Rectangle{X: 5, Y: 5, Width: 5, Height: 5}
+	graze := Rectangle{X: 9, Y: 9, Width: 5, Height: 5}
+
+	tests := []struct {
+		name      string
+		tolerance float64
+		obstacles []Rectangle
+		expected  bool
+	}{
+		{"no obstacles", 0, nil, false},
+		{"all clear", 0, []Rectangle{clear, clear}, false},
+		{"hit after clear", 0, []Rectangle{clear, hit}, true},
+		{"hit before clear", 0, []Rectangle{hit, clear}, true},
+		{"graze without tolerance", 0, []Rectangle{clear, graze}, true},
+		{"graze forgiven by tolerance", 2.0, []Rectangle{clear, graze}, false},
+		{"hit despite tolerance", 2.0, []Rectangle{graze, hit}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ge.SetCollisionTolerance(tt.tolerance)
+			if got := ge.AnyCollision(dino, tt.obstacles); got != tt.expected {
+				t.Errorf("Expected AnyCollision %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+// fakeCollidable is a minimal Scorable for collision processing tests
+type fakeCollidable struct {
+	bounds Rectangle
+	active bool
+	scored bool
+}
+
+func (f *fakeCollidable) GetBounds() Rectangle { return f.bounds }
+func (f *fakeCollidable) IsActive() bool       { return f.active }
+func (f *fakeCollidable) Deactivate()          { f.active = false }
+func (f *fakeCollidable) IsScored() bool       { return f.scored }
+func (f *fakeCollidable) MarkScored()          { f.scored = true }
+
+func TestGameEngineProcessCollisions(t *testing.T) {
+	config := NewDefaultConfig()
+	ge := NewGameEngine(config)
+	ge.SetCollisionTolerance(0)
+
+	dino := Rectangle{X: 10, Y: 0, Width: 5, Height: 5}
+
+	behind := &fakeCollidable{bounds: Rectangle{X: 2, Y: 0, Width: 3, Height: 3}, active: true}
+	scored := &fakeCollidable{bounds: Rectangle{X: 1, Y: 0, Width: 3, Height: 3}, active: true, scored: true}
+	ahead := &fakeCollidable{bounds: Rectangle{X: 30, Y: 0, Width: 3, Height: 3}, active: true}
+	offScreen := &fakeCollidable{bounds: Rectangle{X: -10, Y: 0, Width: 3, Height: 3}, active: true}
+
+	hit, passed := ge.ProcessCollisions(dino, []Scorable{behind, scored, ahead, offScreen}, dino.X)
+	if hit {
+		t.Error("Should not report a hit when no obstacle overlaps")
+	}
+	if len(passed) != 1 || passed[0] != behind {
+		t.Errorf("Expected only the unscored obstacle behind the dino to be passed, got %v", passed)
+	}
+	if offScreen.IsActive() {
+		t.Error("Obstacle that left the screen should be deactivated")
+	}
+
+	// A hit takes priority and reports no passed obstacles
+	overlapping := &fakeCollidable{bounds: Rectangle{X: 12, Y: 2, Width: 3, Height: 3}, active: true}
+	hit, passed = ge.ProcessCollisions(dino, []Scorable{behind, overlapping}, dino.X)
+	if !hit {
+		t.Error("Should report a hit for an overlapping obstacle")
+	}
+	if len(passed) != 0 {
+		t.Errorf("Expected no passed obstacles on a hit, got %d", len(passed))
+	}
+
+	// Inactive obstacles are ignored entirely
+	overlapping.Deactivate()
+	if hit, _ := ge.ProcessCollisions(dino, []Scorable{overlapping}, dino.X); hit {
+		t.Error("Inactive obstacles should not collide")
+	}
+}
+
+func TestGameEngineGodMode(t *testing.T) {
+	config := NewDefaultConfig()
+	ge := NewGameEngine(config)
+	ge.SetCollisionTolerance(0)
+	ge.SetState(StatePlaying)
+
+	if ge.IsGodMode() {
+		t.Fatal("Expected god mode to be off by default")
+	}
+	ge.SetGodMode(true)
+	if !ge.IsGodMode() {
+		t.Fatal("Expected god mode to be on")
+	}
+
+	dino := Rectangle{X: 10, Y: 0, Width: 5, Height: 5}
+	overlapping := &fakeCollidable{bounds: Rectangle{X: 12, Y: 2, Width: 3, Height: 3}, active: true}
+	behind := &fakeCollidable{bounds: Rectangle{X: 2, Y: 0, Width: 3, Height: 3}, active: true}
+
+	hit, passed := ge.ProcessCollisions(dino, []Scorable{overlapping, behind}, dino.X)
+	if hit {
+		t.Error("Expected no hit in god mode")
+	}
+	if len(passed) != 1 || passed[0] != behind {
+		t.Fatalf("Expected the obstacle behind the dino to still be passed, got %v", passed)
+	}
+
+	// Passing obstacles still earns the bonus
+	before := ge.GetCurrentScore()
+	for _, obstacle := range passed {
+		obstacle.MarkScored()
+		ge.AddObstacleBonus()
+	}
+	if ge.GetCurrentScore() != before+config.ScoreObstacleBonus {
+		t.Errorf("Expected the obstacle bonus in god mode, got %d from %d", ge.GetCurrentScore(), before)
+	}
+	if ge.GetState() != StatePlaying {
+		t.Errorf("Expected the run to continue, got %v", ge.GetState())
+	}
+
+	// Turning it off makes obstacles dangerous again
+	ge.SetGodMode(false)
+	if hit, _ := ge.ProcessCollisions(dino, []Scorable{overlapping}, dino.X); !hit {
+		t.Error("Expected a hit with god mode off")
+	}
+}
+
+func TestGameEngineProcessCollisionsPassedThreshold(t *testing.T) {
+	config := NewDefaultConfig()
+	ge := NewGameEngine(config)
+
+	// Keep the dinosaur well clear of the obstacles so only the passed line matters
+	dino := Rectangle{X: 40, Y: 0, Width: 5, Height: 5}
+
+	tests := []struct {
+		name     string
+		x, width float64
+		passedX  float64
+		expected bool
+	}{
+		{"narrow obstacle behind the line", 5, 2, 10, true},
+		{"narrow obstacle touching the line", 8, 2, 10, false},
+		{"wide obstacle still crossing the line", 5, 6, 10, false},
+		{"wide obstacle just past the line", 3, 6, 10, true},
+		{"line moved ahead of the obstacle", 12, 3, 20, true},
+		{"line moved behind the obstacle", 12, 3, 5, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			obstacle := &fakeCollidable{bounds: Rectangle{X: tt.x, Y: 20, Width: tt.width, Height: 3}, active: true}
+			_, passed := ge.ProcessCollisions(dino, []Scorable{obstacle}, tt.passedX)
+			if got := len(passed) == 1; got != tt.expected {
+				t.Errorf("Expected passed %v for right edge %.0f and line %.0f, got %v", tt.expected, tt.x+tt.width, tt.passedX, got)
+			}
+		})
+	}
+}
+
 func TestGameEngineCollisionInfo(t *testing.T) {
 	config := NewDefaultConfig()
 	ge := NewGameEngine(config)
@@ -220,7 +394,7 @@ func TestGameEngineTriggerGameOver(t *testing.T) {
 
 func TestGameEngineGameDuration(t *testing.T) {
 	config := NewDefaultConfig()
-	ge := NewGameEngine(config)
+	ge, fake := newFakeClockEngine(config)
 
 	// Duration should be 0 before starting
 	if ge.GetGameDuration() != 0 {
@@ -230,15 +404,139 @@ func TestGameEngineGameDuration(t *testing.T) {
 	// Start the game
 	ge.Start()
 
-	// Wait a bit
-	time.Sleep(50 * time.Millisecond)
+	fake.Advance(50 * time.Millisecond)
 
-	duration := ge.GetGameDuration()
-	if duration <= 0 {
-		t.Error("Game duration should be positive after starting")
+	if duration := ge.GetGameDuration(); duration != 50*time.Millisecond {
+		t.Errorf("Expected game duration 50ms, got %v", duration)
 	}
-	if duration < 40*time.Millisecond {
-		t.Error("Game duration should be at least the time we waited")
+}
+
+func TestGameEngineTimeAttackEndsAtLimit(t *testing.T) {
+	config := NewDefaultConfig()
+	config.GameMode = ModeTimeAttack
+	config.TimeLimit = 60 * time.Second
+	ge, fake := newFakeClockEngine(config)
+
+	ge.Start()
+	fake.Advance(time.Second)
+	ge.Update()
+	if ge.GetState() != StatePlaying {
+		t.Fatal("Time attack run should keep playing before the limit")
+	}
+	if remaining := ge.GetTimeRemaining(); remaining != 59*time.Second {
+		t.Errorf("Expected 59s remaining, got %v", remaining)
+	}
+
+	// Run exactly up to the time limit
+	fake.Advance(config.TimeLimit - time.Second)
+	ge.Update()
+
+	if ge.GetState() != StateGameOver {
+		t.Errorf("Expected time attack run to end at the limit, got state %v", ge.GetState())
+	}
+	if ge.GetTimeRemaining() != 0 {
+		t.Errorf("Expected no time remaining after the limit, got %v", ge.GetTimeRemaining())
+	}
+}
+
+func TestGameEngineQuitPromptPausesRunClock(t *testing.T) {
+	config := NewDefaultConfig()
+	config.GameMode = ModeTimeAttack
+	config.TimeLimit = 60 * time.Second
+	ge, fake := newFakeClockEngine(config)
+
+	ge.Start()
+	fake.Advance(10 * time.Second)
+	ge.Update()
+
+	// The run and its countdown stand still while the quit prompt is open
+	ge.TransitionTo(StateConfirmQuit)
+	fake.Advance(2 * time.Minute)
+	ge.Update()
+	if ge.GetGameDuration() != 10*time.Second || ge.GetScore().GetGameDuration() != 10*time.Second {
+		t.Errorf("Expected the run to stay at 10s behind the prompt, got %v (score %v)", ge.GetGameDuration(), ge.GetScore().GetGameDuration())
+	}
+
+	ge.TransitionTo(StatePlaying)
+	fake.Advance(time.Second)
+	ge.Update()
+	if ge.GetState() != StatePlaying {
+		t.Fatalf("Expected the run to continue after the prompt, got %v", ge.GetState())
+	}
+	if remaining := ge.GetTimeRemaining(); remaining != 49*time.Second {
+		t.Errorf("Expected 49s remaining after resuming, got %v", remaining)
+	}
+}
+
+func TestGameEngineEndlessIgnoresTime(t *testing.T) {
+	config := NewDefaultConfig()
+	config.TimeLimit = 60 * time.Second
+	ge, fake := newFakeClockEngine(config)
+
+	ge.Start()
+	fake.Advance(10 * time.Minute)
+	ge.Update()
+
+	if ge.GetState() != StatePlaying {
+		t.Errorf("Endless run should never end on time alone, got state %v", ge.GetState())
+	}
+	if ge.IsTimeUp() {
+		t.Error("IsTimeUp should always be false in endless mode")
+	}
+	if ge.GetTimeRemaining() != 0 {
+		t.Errorf("Expected no time remaining in endless mode, got %v", ge.GetTimeRemaining())
+	}
+}
+
+func TestGameEngineSeed(t *testing.T) {
+	config := NewDefaultConfig()
+	config.Seed = 42
+	ge := NewGameEngine(config)
+
+	if ge.GetSeed() != 42 {
+		t.Errorf("Expected seed 42 from config, got %d", ge.GetSeed())
+	}
+
+	// A fixed seed should survive restarts so every run is reproducible
+	ge.Start()
+	ge.TriggerGameOver()
+	ge.Restart()
+	if ge.GetSeed() != 42 {
+		t.Errorf("Expected seed 42 after restart, got %d", ge.GetSeed())
+	}
+
+	ge.SetSeed(7)
+	if ge.GetSeed() != 7 {
+		t.Errorf("Expected seed 7 after SetSeed, got %d", ge.GetSeed())
+	}
+}
+
+func TestGameEngineDefaultSeedRecorded(t *testing.T) {
+	ge := NewGameEngine(NewDefaultConfig())
+
+	seed := ge.GetSeed()
+	if seed == 0 {
+		t.Error("Expected a non-zero default seed to be recorded")
+	}
+
+	// The recorded seed should not change while a run is in progress
+	ge.Start()
+	ge.Update()
+	if ge.GetSeed() != seed {
+		t.Errorf("Expected seed %d to be stable during a run, got %d", seed, ge.GetSeed())
+	}
+}
+
+func TestGameEngineDefaultSeedChangesPerRun(t *testing.T) {
+	ge := NewGameEngine(NewDefaultConfig())
+
+	ge.Start()
+	seed := ge.GetSeed()
+	ge.TriggerGameOver()
+	ge.Stop()
+	ge.Start()
+	if ge.GetSeed() == seed {
+		t.Errorf("Expected a new seed for a run started from the menu, got %d again", seed)
 	}
 }
 
@@ -262,6 +560,24 @@ func TestGameEngineRestart(t *testing.T) {
 	}
 }
 
+func TestGameEngineRestartOnSeed(t *testing.T) {
+	ge := NewGameEngine(NewDefaultConfig())
+
+	ge.Start()
+	seed := ge.GetSeed()
+	ge.TriggerGameOver()
+	ge.RestartOnSeed(seed)
+	if ge.GetState() != StatePlaying || ge.GetSeed() != seed {
+		t.Errorf("Expected a run on seed %d, got seed %d in %v", seed, ge.GetSeed(), ge.GetState())
+	}
+
+	// Only a finished run restarts
+	ge.RestartOnSeed(seed + 1)
+	if ge.GetSeed() != seed {
+		t.Errorf("Expected a run in progress to keep seed %d, got %d", seed, ge.GetSeed())
+	}
+}
+
 func TestGameEngineCollisionDebug(t *testing.T) {
 	config := NewDefaultConfig()
 	ge := NewGameEngine(config)
@@ -375,6 +691,101 @@ func TestGameEngineStateTransitions(t *testing.T) {
 	if ge.CanTransitionTo(StateGameOver) {
 		t.Error("Should not be able to transition from Menu to GameOver")
 	}
+	if !ge.CanTransitionTo(StateSettings) {
+		t.Error("Should be able to transition from Menu to Settings")
+	}
+
+	// Settings only leads back to the menu
+	ge.SetState(StateSettings)
+	if ge.CanTransitionTo(StatePlaying) {
+		t.Error("Should not be able to transition from Settings to Playing")
+	}
+	if !ge.TransitionTo(StateMenu) {
+		t.Error("Should be able to transition from Settings back to Menu")
+	}
+
+	// The help screen opens from the menu and only leads back to it
+	if !ge.TransitionTo(StateHelp) {
+		t.Error("Should be able to transition from Menu to Help")
+	}
+	if ge.CanTransitionTo(StatePlaying) {
+		t.Error("Should not be able to transition from Help to Playing")
+	}
+	if !ge.TransitionTo(StateMenu) {
+		t.Error("Should be able to transition from Help back to Menu")
+	}
+
+	// So does the leaderboard
+	if !ge.TransitionTo(StateLeaderboard) {
+		t.Error("Should be able to transition from Menu to Leaderboard")
+	}
+	if ge.CanTransitionTo(StatePlaying) {
+		t.Error("Should not be able to transition from Leaderboard to Playing")
+	}
+	if !ge.TransitionTo(StateMenu) {
+		t.Error("Should be able to transition from Leaderboard back to Menu")
+	}
+
+	// The splash screen only leads to the menu
+	ge.SetState(StateSplash)
+	if ge.CanTransitionTo(StatePlaying) {
+		t.Error("Should not be able to transition from Splash to Playing")
+	}
+	if !ge.TransitionTo(StateMenu) {
+		t.Error("Should be able to transition from Splash to Menu")
+	}
+
+	// The run summary sits between game over and the menu
+	ge.SetState(StateGameOver)
+	if !ge.TransitionTo(StateSummary) {
+		t.Error("Should be able to transition from GameOver to Summary")
+	}
+	if ge.CanTransitionTo(StatePlaying) {
+		t.Error("Should not be able to transition from Summary to Playing")
+	}
+	if !ge.TransitionTo(StateMenu) {
+		t.Error("Should be able to transition from Summary to Menu")
+	}
+
+	// Quitting from the menu asks for confirmation first
+	if !ge.TransitionTo(StateConfirmQuit) {
+		t.Error("Should be able to transition from Menu to ConfirmQuit")
+	}
+	if ge.CanTransitionTo(StatePlaying) {
+		t.Error("Should not be able to transition from ConfirmQuit to Playing")
+	}
+	if !ge.TransitionTo(StateMenu) {
+		t.Error("Should be able to transition from ConfirmQuit back to Menu")
+	}
+
+	// The quit prompt can also open mid-run, and resumes it without resetting the score
+	ge.SetState(StatePlaying)
+	ge.AddObstacleBonus()
+	scoreBefore := ge.GetCurrentScore()
+	if !ge.TransitionTo(StateConfirmQuit) {
+		t.Error("Should be able to transition from Playing to ConfirmQuit")
+	}
+	if ge.CanTransitionTo(StateMenu) {
+		t.Error("Should only be able to return from ConfirmQuit to the screen it opened over")
+	}
+	if !ge.TransitionTo(StatePlaying) {
+		t.Error("Should be able to resume Playing from ConfirmQuit")
+	}
+	if ge.GetCurrentScore() != scoreBefore {
+		t.Errorf("Expected resuming to keep score %d, got %d", scoreBefore, ge.GetCurrentScore())
+	}
+	ge.SetState(StateMenu)
+
+	// The attract demo runs from the menu and leads into a real game or back to the menu
+	if !ge.TransitionTo(StateAttract) {
+		t.Error("Should be able to transition from Menu to Attract")
+	}
+	if ge.CanTransitionTo(StateGameOver) {
+		t.Error("Should not be able to transition from Attract to GameOver")
+	}
+	if !ge.TransitionTo(StateMenu) {
+		t.Error("Should be able to transition from Attract to Menu")
+	}
 
 	// Transition to Playing
 	if !ge.TransitionTo(StatePlaying) {
@@ -525,11 +936,11 @@ func TestGameEngineInvalidTransitions(t *testing.T) {
 
 func TestGameEngineRestartFromGameOver(t *testing.T) {
 	config := NewDefaultConfig()
-	ge := NewGameEngine(config)
+	ge, fake := newFakeClockEngine(config)
 
-	// Start game and wait to accumulate some duration
+	// Start game and play for a while to accumulate some duration
 	ge.Start()
-	time.Sleep(50 * time.Millisecond)
+	fake.Advance(50 * time.Millisecond)
 	originalDuration := ge.GetGameDuration()
 	ge.TriggerGameOver()
 
@@ -537,8 +948,8 @@ func TestGameEngineRestartFromGameOver(t *testing.T) {
 		t.Error("State should be GameOver after TriggerGameOver()")
 	}
 
-	// Wait a bit more to ensure time difference
-	time.Sleep(10 * time.Millisecond)
+	// Let a little more time pass on the game over screen
+	fake.Advance(10 * time.Millisecond)
 
 	// Restart should work from GameOver state
 	ge.Restart()
@@ -553,13 +964,12 @@ func TestGameEngineRestartFromGameOver(t *testing.T) {
 		t.Error("Game should not be in game over state after Restart()")
 	}
 
-	// Game duration should be reset (new start time should be much smaller)
-	newDuration := ge.GetGameDuration()
-	if newDuration >= originalDuration {
-		t.Errorf("Game duration should be reset after restart. Original: %v, New: %v", originalDuration, newDuration)
+	// Game duration should be reset by the restart
+	if originalDuration != 50*time.Millisecond {
+		t.Errorf("Expected original duration 50ms, got %v", originalDuration)
 	}
-	if newDuration > 10*time.Millisecond {
-		t.Errorf("New game duration should be very small after restart, got: %v", newDuration)
+	if newDuration := ge.GetGameDuration(); newDuration != 0 {
+		t.Errorf("Game duration should be reset after restart, got: %v", newDuration)
 	}
 }
 
@@ -692,6 +1102,30 @@ func TestGameEngineObstacleBonus(t *testing.T) {
 	}
 }
 
+func TestGameEngineScoreUsesConfig(t *testing.T) {
+	config := NewDefaultConfig()
+	config.ScoreTimeMultiplier = 5
+	config.ScoreObstacleBonus = 250
+	config.ScoreDistanceMultiplier = 2.5
+	engine := NewGameEngine(config)
+
+	gameScore := engine.GetScore()
+	if gameScore.TimeMultiplier != 5 {
+		t.Errorf("Expected time multiplier 5, got %d", gameScore.TimeMultiplier)
+	}
+	if gameScore.ObstacleBonus != 250 {
+		t.Errorf("Expected obstacle bonus 250, got %d", gameScore.ObstacleBonus)
+	}
+	if gameScore.DistanceMultiplier != 2.5 {
+		t.Errorf("Expected distance multiplier 2.5, got %f", gameScore.DistanceMultiplier)
+	}
+
+	engine.AddObstacleBonus()
+	if engine.GetCurrentScore() != 250 {
+		t.Errorf("Expected configured obstacle bonus of 250 to be applied, got %d", engine.GetCurrentScore())
+	}
+}
+
 func TestGameEngineScoreStateTransitions(t *testing.T) {
 	config := NewDefaultConfig()
 	engine := NewGameEngine(config)
@@ -757,3 +1191,231 @@ func TestGameEngineHighScore(t *testing.T) {
 		t.Error("Expected FinalizeScore to return true for new high score")
 	}
 }
+
+func TestGameEngineResetFrameClock(t *testing.T) {
+	ge, fake := newFakeClockEngine(NewDefaultConfig())
+
+	// Simulate a long pause since the last update
+	fake.Advance(5 * time.Second)
+	ge.ResetFrameClock()
+	ge.Update()
+
+	if ge.GetDeltaTime() != 0 {
+		t.Errorf("Expected the paused time to be discarded, got delta %f", ge.GetDeltaTime())
+	}
+}
+
+func TestGameEngineStepping(t *testing.T) {
+	config := NewDefaultConfig()
+	ge, fake := newFakeClockEngine(config)
+
+	if !ge.ShouldUpdate() {
+		t.Error("Expected every frame to update when not stepping")
+	}
+
+	ge.SetStepping(true)
+	if !ge.IsStepping() || ge.ShouldUpdate() {
+		t.Error("Expected no updates while stepping without a queued step")
+	}
+
+	// One step is one update, of exactly one frame however long the pause was
+	ge.StepOnce()
+	if !ge.ShouldUpdate() {
+		t.Fatal("Expected a queued step to allow an update")
+	}
+	fake.Advance(3 * time.Second)
+	ge.Update()
+	if want := 1.0 / float64(config.TargetFPS); ge.GetDeltaTime() != want {
+		t.Errorf("Expected a step to cover one frame (%f), got %f", want, ge.GetDeltaTime())
+	}
+	if ge.ShouldUpdate() {
+		t.Error("Expected a step to allow only one update")
+	}
+
+	// Leaving step mode discards the paused time
+	fake.Advance(time.Second)
+	ge.SetStepping(false)
+	ge.Update()
+	if ge.GetDeltaTime() != 0 {
+		t.Errorf("Expected the paused time to be discarded, got delta %f", ge.GetDeltaTime())
+	}
+
+	// Steps are ignored outside step mode
+	ge.StepOnce()
+	ge.SetStepping(true)
+	if ge.ShouldUpdate() {
+		t.Error("Expected a step requested outside step mode to be dropped")
+	}
+}
+
+func TestGameEngineHoldRun(t *testing.T) {
+	ge, fake := newFakeClockEngine(NewDefaultConfig())
+
+	ge.Start()
+	fake.Advance(10 * time.Second)
+	ge.Update()
+
+	// Holds stack, and the run only moves again once every hold is released
+	ge.HoldRun()
+	ge.HoldRun()
+	fake.Advance(time.Minute)
+	ge.ReleaseRun()
+	fake.Advance(time.Minute)
+	if got := ge.GetGameDuration(); got != 10*time.Second {
+		t.Errorf("Expected the held run to stay at 10s, got %v", got)
+	}
+
+	// Stepping keeps the clock stopped after the last release
+	ge.SetStepping(true)
+	ge.ReleaseRun()
+	fake.Advance(time.Minute)
+	if got := ge.GetGameDuration(); got != 10*time.Second {
+		t.Errorf("Expected the run to stay paused for stepping, got %v", got)
+	}
+
+	// The held time isn't one long frame either
+	ge.SetStepping(false)
+	ge.Update()
+	if ge.GetDeltaTime() != 0 {
+		t.Errorf("Expected no frame time for the held minutes, got %f", ge.GetDeltaTime())
+	}
+	fake.Advance(time.Second)
+	if got := ge.GetGameDuration(); got != 11*time.Second {
+		t.Errorf("Expected the run to move again after the holds, got %v", got)
+	}
+
+	// An unmatched release does nothing
+	ge.ReleaseRun()
+	ge.HoldRun()
+	fake.Advance(time.Second)
+	if got := ge.GetGameDuration(); got != 11*time.Second {
+		t.Errorf("Expected a new hold to stop the run, got %v", got)
+	}
+}
+
+func TestGameEngineSteppingPausesRunClock(t *testing.T) {
+	config := NewDefaultConfig()
+	config.GameMode = ModeTimeAttack
+	config.TimeLimit = 60 * time.Second
+	ge, fake := newFakeClockEngine(config)
+
+	ge.Start()
+	fake.Advance(10 * time.Second)
+	ge.Update()
+
+	// The run and its countdown stand still while paused for stepping
+	ge.SetStepping(true)
+	fake.Advance(2 * time.Minute)
+	if ge.GetGameDuration() != 10*time.Second || ge.GetScore().GetGameDuration() != 10*time.Second {
+		t.Errorf("Expected the paused run to stay at 10s, got %v (score %v)", ge.GetGameDuration(), ge.GetScore().GetGameDuration())
+	}
+
+	// Each step moves the run on by one frame
+	frame := time.Duration(float64(time.Second) / float64(config.TargetFPS))
+	ge.StepOnce()
+	if ge.ShouldUpdate() {
+		ge.Update()
+	}
+	if got := ge.GetGameDuration(); got != 10*time.Second+frame {
+		t.Errorf("Expected a step to add one frame to the run, got %v", got)
+	}
+
+	// The quit prompt doesn't restart a run paused for stepping
+	ge.TransitionTo(StateConfirmQuit)
+	ge.TransitionTo(StatePlaying)
+	fake.Advance(time.Minute)
+	if ge.GetState() != StatePlaying || ge.GetGameDuration() != 10*time.Second+frame {
+		t.Errorf("Expected the run to stay paused after the prompt, got %v in %v", ge.GetGameDuration(), ge.GetState())
+	}
+
+	ge.SetStepping(false)
+	fake.Advance(time.Second)
+	ge.Update()
+	if remaining := ge.GetTimeRemaining(); remaining != 49*time.Second-frame {
+		t.Errorf("Expected %v remaining after resuming, got %v", 49*time.Second-frame, remaining)
+	}
+}
+
+func TestGameEngineUpdateWithDelta(t *testing.T) {
+	config := NewDefaultConfig()
+	ge, fake := newFakeClockEngine(config)
+	ge.SetState(StatePlaying)
+
+	// The given delta is used whatever the clock says
+	fake.Advance(5 * time.Second)
+	ge.UpdateWithDelta(0.25)
+	if ge.GetDeltaTime() != 0.25 {
+		t.Errorf("Expected delta 0.25, got %f", ge.GetDeltaTime())
+	}
+
+	distance := ge.GetScore().Distance
+	ge.UpdateWithDelta(0.5)
+	if ge.GetDeltaTime() != 0.5 {
+		t.Errorf("Expected delta 0.5, got %f", ge.GetDeltaTime())
+	}
+	if got := ge.GetScore().Distance - distance; got != 0.5*10.0 {
+		t.Errorf("Expected the score distance to advance by 5 for half a second, got %f", got)
+	}
+
+	// Update measures its delta from the last update, explicit or not
+	fake.Advance(100 * time.Millisecond)
+	ge.Update()
+	if math.Abs(ge.GetDeltaTime()-0.1) > 1e-9 {
+		t.Errorf("Expected a measured delta of 0.1, got %f", ge.GetDeltaTime())
+	}
+}
+
+func TestGameEngineIdleTimeout(t *testing.T) {
+	config := NewDefaultConfig()
+	config.IdleTimeout = 30 * time.Second
+
+	for _, state := range []GameState{StateGameOver, StateSummary, StateSettings, StateHelp, StateLeaderboard} {
+		t.Run(state.String(), func(t *testing.T) {
+			ge, fake := newFakeClockEngine(config)
+			ge.SetState(StatePlaying)
+			ge.SetState(state)
+
+			// Input restarts the timer
+			fake.Advance(20 * time.Second)
+			ge.RecordInput()
+			fake.Advance(20 * time.Second)
+			ge.Update()
+			if ge.GetState() != state {
+				t.Fatalf("Expected to stay on %v after input, got %v", state, ge.GetState())
+			}
+			if ge.GetIdleTime() != 20*time.Second {
+				t.Errorf("Expected 20s idle, got %v", ge.GetIdleTime())
+			}
+
+			fake.Advance(10 * time.Second)
+			ge.Update()
+			if ge.GetState() != StateMenu {
+				t.Errorf("Expected %v to return to the menu after the idle timeout, got %v", state, ge.GetState())
+			}
+		})
+	}
+
+	// Runs and the menu never time out
+	for _, state := range []GameState{StatePlaying, StateMenu} {
+		ge, fake := newFakeClockEngine(config)
+		ge.SetState(StatePlaying)
+		ge.SetState(state)
+		fake.Advance(time.Minute)
+		ge.Update()
+		if ge.GetState() != state {
+			t.Errorf("Expected %v not to time out, got %v", state, ge.GetState())
+		}
+	}
+
+	// A zero timeout disables it
+	disabled := NewDefaultConfig()
+	disabled.IdleTimeout = 0
+	ge, fake := newFakeClockEngine(disabled)
+	ge.SetState(StatePlaying)
+	ge.SetState(StateGameOver)
+	fake.Advance(time.Hour)
+	ge.Update()
+	if ge.GetState() != StateGameOver {
+		t.Errorf("Expected no idle timeout when disabled, got %v", ge.GetState())
+	}
+}
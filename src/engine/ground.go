@@ -0,0 +1,32 @@
+package engine
+
+// GroundModel places the ground for a screen, so the dinosaur, the obstacles,
+// and the drawn ground line agree on where it is. The ground line is drawn on
+// the bottom row; obstacles stand on it and the dinosaur's feet rest on it.
+type GroundModel struct {
+	screenHeight int
+	dinoHeight   float64
+}
+
+// NewGroundModel creates the ground for a screen of the given height and a
+// dinosaur of the given height, in rows
+func NewGroundModel(screenHeight int, dinoHeight float64) GroundModel {
+	return GroundModel{screenHeight: screenHeight, dinoHeight: dinoHeight}
+}
+
+// LineY returns the row the ground line is drawn on
+func (g GroundModel) LineY() int {
+	return g.screenHeight - 1
+}
+
+// ObstacleGroundY returns the Y obstacles are placed against, which is the top
+// of the ground line row, so ground obstacles sit on the row above it
+func (g GroundModel) ObstacleGroundY() float64 {
+	return float64(g.LineY())
+}
+
+// DinoGroundY returns the dinosaur's Y while it stands on the ground, its sprite
+// ending just above the ground line
+func (g GroundModel) DinoGroundY() float64 {
+	return g.ObstacleGroundY() - g.dinoHeight
+}
@@ -0,0 +1,40 @@
+package engine
+
+import "testing"
+
+func TestGroundModel(t *testing.T) {
+	tests := []struct {
+		name         string
+		screenHeight int
+		dinoHeight   float64
+		lineY        int
+		obstacleY    float64
+		dinoY        float64
+	}{
+		{"minimum screen", MinScreenHeight, 4, MinScreenHeight - 1, float64(MinScreenHeight - 1), float64(MinScreenHeight - 5)},
+		{"default config", 20, 4, 19, 19, 15},
+		{"80x24 terminal", 24, 4, 23, 23, 19},
+		{"tall screen", 50, 4, 49, 49, 45},
+		{"double scale", 40, 8, 39, 39, 31},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ground := NewGroundModel(tt.screenHeight, tt.dinoHeight)
+			if ground.LineY() != tt.lineY {
+				t.Errorf("Expected the ground line on row %d, got %d", tt.lineY, ground.LineY())
+			}
+			if ground.ObstacleGroundY() != tt.obstacleY {
+				t.Errorf("Expected obstacles against y=%g, got %g", tt.obstacleY, ground.ObstacleGroundY())
+			}
+			if ground.DinoGroundY() != tt.dinoY {
+				t.Errorf("Expected the dinosaur at y=%g, got %g", tt.dinoY, ground.DinoGroundY())
+			}
+
+			// The dinosaur's feet rest where obstacles stand
+			if ground.DinoGroundY()+tt.dinoHeight != ground.ObstacleGroundY() {
+				t.Errorf("Expected the dinosaur's feet at y=%g, got %g", ground.ObstacleGroundY(), ground.DinoGroundY()+tt.dinoHeight)
+			}
+		})
+	}
+}
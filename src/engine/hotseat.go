@@ -0,0 +1,80 @@
+package engine
+
+// PlayerSession tracks a hotseat match, where players take turns at single-player
+// runs on the same keyboard and the best score wins
+type PlayerSession struct {
+	scores []int // Score of each player's run, in turn order
+	turn   int   // Index of the player whose run is next
+}
+
+// NewPlayerSession creates a session for the given number of players, at least one
+func NewPlayerSession(players int) *PlayerSession {
+	if players < 1 {
+		players = 1
+	}
+	return &PlayerSession{scores: make([]int, players)}
+}
+
+// GetPlayerCount returns how many players take turns
+func (ps *PlayerSession) GetPlayerCount() int {
+	return len(ps.scores)
+}
+
+// GetCurrentPlayer returns the player whose run is next, counting from 1
+func (ps *PlayerSession) GetCurrentPlayer() int {
+	return ps.turn + 1
+}
+
+// GetTurnsPlayed returns how many players have finished their run
+func (ps *PlayerSession) GetTurnsPlayed() int {
+	return ps.turn
+}
+
+// RecordTurn records the current player's score and passes the turn on.
+// Scores after every player has had a turn are ignored.
+func (ps *PlayerSession) RecordTurn(score int) {
+	if ps.IsComplete() {
+		return
+	}
+	ps.scores[ps.turn] = score
+	ps.turn++
+}
+
+// IsComplete reports whether every player has had a turn
+func (ps *PlayerSession) IsComplete() bool {
+	return ps.turn >= len(ps.scores)
+}
+
+// GetScore returns the given player's score, counting from 1, or 0 for a player
+// who hasn't played yet
+func (ps *PlayerSession) GetScore(player int) int {
+	if player < 1 || player > ps.turn {
+		return 0
+	}
+	return ps.scores[player-1]
+}
+
+// GetScores returns the scores of the players who have had their turn, in turn order
+func (ps *PlayerSession) GetScores() []int {
+	return append([]int(nil), ps.scores[:ps.turn]...)
+}
+
+// GetWinner returns the player with the highest score so far, counting from 1,
+// and whether another player has the same score
+func (ps *PlayerSession) GetWinner() (player int, tie bool) {
+	for i, score := range ps.scores[:ps.turn] {
+		switch {
+		case player == 0 || score > ps.scores[player-1]:
+			player, tie = i+1, false
+		case score == ps.scores[player-1]:
+			tie = true
+		}
+	}
+	return player, tie
+}
+
+// Reset clears the scores for a new match with the same players
+func (ps *PlayerSession) Reset() {
+	ps.scores = make([]int, len(ps.scores))
+	ps.turn = 0
+}
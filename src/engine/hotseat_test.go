@@ -0,0 +1,75 @@
+package engine
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPlayerSessionTracksScoresIndependently(t *testing.T) {
+	session := NewPlayerSession(2)
+	if session.GetCurrentPlayer() != 1 {
+		t.Errorf("Expected player 1 to go first, got player %d", session.GetCurrentPlayer())
+	}
+
+	session.RecordTurn(120)
+	if session.IsComplete() {
+		t.Error("Expected player 2 still to play")
+	}
+	if session.GetCurrentPlayer() != 2 {
+		t.Errorf("Expected player 2 next, got player %d", session.GetCurrentPlayer())
+	}
+	if session.GetScore(2) != 0 {
+		t.Errorf("Expected no score for player 2 before their turn, got %d", session.GetScore(2))
+	}
+
+	session.RecordTurn(85)
+	if !session.IsComplete() {
+		t.Error("Expected the session to be complete after both turns")
+	}
+	if session.GetScore(1) != 120 || session.GetScore(2) != 85 {
+		t.Errorf("Expected scores 120 and 85, got %d and %d", session.GetScore(1), session.GetScore(2))
+	}
+
+	// A further run doesn't overwrite either score
+	session.RecordTurn(500)
+	if !reflect.DeepEqual(session.GetScores(), []int{120, 85}) {
+		t.Errorf("Expected scores [120 85], got %v", session.GetScores())
+	}
+
+	session.Reset()
+	if session.GetCurrentPlayer() != 1 || len(session.GetScores()) != 0 {
+		t.Errorf("Expected a fresh session after reset, got player %d with %v", session.GetCurrentPlayer(), session.GetScores())
+	}
+}
+
+func TestPlayerSessionWinner(t *testing.T) {
+	tests := []struct {
+		name   string
+		scores []int
+		winner int
+		tie    bool
+	}{
+		{"first player higher", []int{300, 150}, 1, false},
+		{"second player higher", []int{150, 300}, 2, false},
+		{"tie", []int{200, 200}, 1, true},
+		{"tie broken later", []int{100, 100, 250}, 3, false},
+		{"only one turn played", []int{40}, 1, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			session := NewPlayerSession(len(tt.scores))
+			for _, score := range tt.scores {
+				session.RecordTurn(score)
+			}
+			winner, tie := session.GetWinner()
+			if winner != tt.winner || tie != tt.tie {
+				t.Errorf("Expected winner %d (tie %v), got %d (tie %v)", tt.winner, tt.tie, winner, tie)
+			}
+		})
+	}
+
+	if winner, _ := NewPlayerSession(2).GetWinner(); winner != 0 {
+		t.Errorf("Expected no winner before anyone plays, got player %d", winner)
+	}
+}
@@ -0,0 +1,59 @@
+package engine
+
+import "time"
+
+// RenderThrottle draws frames at a lower rate than the game updates, for slow
+// terminals such as high-latency SSH where flushing every update causes lag.
+// Time from each update builds up until a render interval has passed.
+type RenderThrottle struct {
+	renderInterval time.Duration
+	accumulated    time.Duration // Update time since the last drawn frame
+}
+
+// NewRenderThrottle creates a throttle that draws at most once per renderInterval.
+// An interval of 0 draws after every update.
+func NewRenderThrottle(renderInterval time.Duration) *RenderThrottle {
+	rt := &RenderThrottle{}
+	rt.SetRenderInterval(renderInterval)
+	return rt
+}
+
+// SetRenderInterval sets the shortest time between drawn frames; 0 draws after every update
+func (rt *RenderThrottle) SetRenderInterval(interval time.Duration) {
+	if interval < 0 {
+		interval = 0
+	}
+	rt.renderInterval = interval
+	rt.accumulated = 0
+}
+
+// GetRenderInterval returns the shortest time between drawn frames
+func (rt *RenderThrottle) GetRenderInterval() time.Duration {
+	return rt.renderInterval
+}
+
+// ShouldFlush adds an update lasting elapsed and reports whether a frame should
+// be drawn after it. Time left over carries to the next frame, so rates that
+// don't divide evenly still average out, but never more than one frame's worth
+// so a long pause doesn't cause a burst of draws.
+func (rt *RenderThrottle) ShouldFlush(elapsed time.Duration) bool {
+	if rt.renderInterval <= 0 {
+		return true
+	}
+
+	rt.accumulated += elapsed
+	if rt.accumulated < rt.renderInterval {
+		return false
+	}
+
+	rt.accumulated -= rt.renderInterval
+	if rt.accumulated > rt.renderInterval {
+		rt.accumulated = rt.renderInterval
+	}
+	return true
+}
+
+// Reset draws the next frame, for when the screen must be brought up to date
+func (rt *RenderThrottle) Reset() {
+	rt.accumulated = rt.renderInterval
+}
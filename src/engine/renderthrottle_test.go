@@ -0,0 +1,103 @@
+package engine
+
+import (
+	"testing"
+	"time"
+)
+
+// flushPattern returns which of n updates lasting update are drawn at the given render rate
+func flushPattern(update, render time.Duration, n int) []bool {
+	rt := NewRenderThrottle(render)
+	flushed := make([]bool, n)
+	for i := range flushed {
+		flushed[i] = rt.ShouldFlush(update)
+	}
+	return flushed
+}
+
+func TestRenderThrottleRates(t *testing.T) {
+	tests := []struct {
+		name      string
+		updateFPS int
+		renderFPS int
+		updates   int
+		expected  int
+	}{
+		{"half rate", 30, 15, 30, 15},
+		{"uneven rate", 30, 20, 30, 20},
+		{"third rate", 60, 20, 60, 20},
+		{"render faster than updates", 15, 30, 15, 15},
+		{"equal rates", 30, 30, 30, 30},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			update := time.Second / time.Duration(tt.updateFPS)
+			render := time.Second / time.Duration(tt.renderFPS)
+
+			flushes := 0
+			for _, flushed := range flushPattern(update, render, tt.updates) {
+				if flushed {
+					flushes++
+				}
+			}
+			// Within a frame, since the durations round to whole nanoseconds
+			if flushes < tt.expected-1 || flushes > tt.expected {
+				t.Errorf("Expected %d flushes in %d updates, got %d", tt.expected, tt.updates, flushes)
+			}
+		})
+	}
+}
+
+func TestRenderThrottleHalfRatePattern(t *testing.T) {
+	// At 30 updates and 15 renders a second every other update is drawn
+	pattern := flushPattern(time.Second/30, time.Second/15, 6)
+	expected := []bool{false, true, false, true, false, true}
+	for i := range expected {
+		if pattern[i] != expected[i] {
+			t.Errorf("Update %d: expected flush=%v, got %v", i, expected[i], pattern[i])
+		}
+	}
+}
+
+func TestRenderThrottleOff(t *testing.T) {
+	rt := NewRenderThrottle(0)
+	for i := 0; i < 5; i++ {
+		if !rt.ShouldFlush(time.Millisecond) {
+			t.Fatalf("Update %d: expected every update drawn with the throttle off", i)
+		}
+	}
+
+	rt.SetRenderInterval(-time.Second)
+	if rt.GetRenderInterval() != 0 {
+		t.Errorf("Expected a negative interval to clamp to 0, got %v", rt.GetRenderInterval())
+	}
+}
+
+func TestRenderThrottleNoBurstAfterPause(t *testing.T) {
+	rt := NewRenderThrottle(100 * time.Millisecond)
+
+	// A long update draws once, then waits for the next interval again
+	if !rt.ShouldFlush(time.Second) {
+		t.Error("Expected a frame after a long update")
+	}
+	flushes := 0
+	for i := 0; i < 5; i++ {
+		if rt.ShouldFlush(10 * time.Millisecond) {
+			flushes++
+		}
+	}
+	if flushes > 1 {
+		t.Errorf("Expected at most one catch-up frame after a pause, got %d", flushes)
+	}
+}
+
+func TestRenderThrottleReset(t *testing.T) {
+	rt := NewRenderThrottle(100 * time.Millisecond)
+	rt.ShouldFlush(10 * time.Millisecond)
+
+	rt.Reset()
+	if !rt.ShouldFlush(0) {
+		t.Error("Expected the next update to be drawn after a reset")
+	}
+}
@@ -0,0 +1,53 @@
+package engine
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+)
+
+// RunResult summarizes a finished run for analytics and automated testing
+type RunResult struct {
+	Score           int        `json:"score"`
+	HighScore       int        `json:"high_score"`
+	ObstaclesPassed int        `json:"obstacles_passed"`
+	Distance        float64    `json:"distance"`
+	DurationSeconds float64    `json:"duration_seconds"`
+	Seed            int64      `json:"seed"`
+	Difficulty      Difficulty `json:"difficulty"`
+}
+
+// GetRunResult returns the summary of the current or just finished run
+func (ge *GameEngine) GetRunResult() RunResult {
+	return RunResult{
+		Score:           ge.gameScore.GetCurrent(),
+		HighScore:       ge.gameScore.GetHigh(),
+		ObstaclesPassed: ge.gameScore.GetObstaclesPassed(),
+		Distance:        ge.gameScore.GetDistance(),
+		DurationSeconds: ge.gameScore.GetGameDuration().Seconds(),
+		Seed:            ge.seed,
+		Difficulty:      ge.config.Difficulty,
+	}
+}
+
+// WriteRunResult writes a run summary as a single line of JSON
+func WriteRunResult(w io.Writer, result RunResult) error {
+	data, err := json.Marshal(result)
+	if err != nil {
+		return fmt.Errorf("failed to marshal run result: %w", err)
+	}
+	if _, err := w.Write(append(data, '\n')); err != nil {
+		return fmt.Errorf("failed to write run result: %w", err)
+	}
+	return nil
+}
+
+// OpenRunLog opens a file to append run summaries to, creating it if needed
+func OpenRunLog(path string) (*os.File, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open run log: %w", err)
+	}
+	return file, nil
+}
@@ -0,0 +1,105 @@
+package engine
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestGetRunResult(t *testing.T) {
+	t.Setenv("HOME", t.TempDir()) // Game over saves the high score
+
+	config := NewDefaultConfig()
+	config.ApplyDifficulty(DifficultyHard)
+	ge, fake := newFakeClockEngine(config)
+	ge.SetSeed(42)
+
+	ge.SetState(StatePlaying)
+	ge.GetScore().SetDistanceRate(10)
+	fake.Advance(3 * time.Second)
+	ge.GetScore().Update(3.0)
+	ge.AddObstacleBonus()
+	ge.AddObstacleBonus()
+	ge.TriggerGameOver()
+
+	result := ge.GetRunResult()
+	if result.Score != ge.GetCurrentScore() || result.HighScore != ge.GetHighScore() {
+		t.Errorf("Expected score %d and high score %d, got %+v", ge.GetCurrentScore(), ge.GetHighScore(), result)
+	}
+	if result.ObstaclesPassed != 2 {
+		t.Errorf("Expected 2 obstacles passed, got %d", result.ObstaclesPassed)
+	}
+	if result.Distance != 30 {
+		t.Errorf("Expected distance 30, got %f", result.Distance)
+	}
+	if result.DurationSeconds != 3 {
+		t.Errorf("Expected a 3s run, got %f", result.DurationSeconds)
+	}
+	if result.Seed != 42 || result.Difficulty != DifficultyHard {
+		t.Errorf("Expected seed 42 on hard, got %d on %s", result.Seed, result.Difficulty)
+	}
+}
+
+func TestWriteRunResult(t *testing.T) {
+	result := RunResult{
+		Score:           1435,
+		HighScore:       2000,
+		ObstaclesPassed: 7,
+		Distance:        315.5,
+		DurationSeconds: 42,
+		Seed:            42,
+		Difficulty:      DifficultyEasy,
+	}
+
+	var buf bytes.Buffer
+	if err := WriteRunResult(&buf, result); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !strings.HasSuffix(buf.String(), "}\n") || strings.Count(buf.String(), "\n") != 1 {
+		t.Errorf("Expected a single line of JSON, got %q", buf.String())
+	}
+
+	var fields map[string]interface{}
+	if err := json.Unmarshal(buf.Bytes(), &fields); err != nil {
+		t.Fatalf("Failed to parse run result: %v", err)
+	}
+	expected := map[string]interface{}{
+		"score":            1435.0,
+		"high_score":       2000.0,
+		"obstacles_passed": 7.0,
+		"distance":         315.5,
+		"duration_seconds": 42.0,
+		"seed":             42.0,
+		"difficulty":       "easy",
+	}
+	for key, want := range expected {
+		if fields[key] != want {
+			t.Errorf("Expected %s to be %v, got %v", key, want, fields[key])
+		}
+	}
+}
+
+func TestOpenRunLogAppends(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "runs.jsonl")
+
+	for i := 0; i < 2; i++ {
+		file, err := OpenRunLog(path)
+		if err != nil {
+			t.Fatalf("Failed to open run log: %v", err)
+		}
+		WriteRunResult(file, RunResult{Score: i})
+		file.Close()
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Failed to read run log: %v", err)
+	}
+	if lines := strings.Split(strings.TrimSpace(string(data)), "\n"); len(lines) != 2 {
+		t.Errorf("Expected one line per run, got %q", string(data))
+	}
+}
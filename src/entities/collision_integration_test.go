@@ -296,3 +296,43 @@ func TestCollisionWithVariousObstacleSizes(t *testing.T) {
 		})
 	}
 }
+
+// Both entity types satisfy the engine's Collidable interface
+var (
+	_ engine.Collidable = (*Dinosaur)(nil)
+	_ engine.Collidable = (*Obstacle)(nil)
+	_ engine.Scorable   = (*Obstacle)(nil)
+)
+
+// TestEntitiesWithGenericCollision tests entities through the engine's Collidable helper
+func TestEntitiesWithGenericCollision(t *testing.T) {
+	config := engine.NewDefaultConfig()
+	gameEngine := engine.NewGameEngine(config)
+	gameEngine.SetCollisionTolerance(0)
+
+	// Obstacles stand on the ground below the dinosaur's feet
+	ground := engine.NewGroundModel(25, DinosaurHeight(1))
+	dinosaur := NewDinosaur(ground.DinoGroundY())
+	obstacleGround := ground.ObstacleGroundY()
+	cactus := NewObstacle(CactusSmall, dinosaur.X, obstacleGround, config)
+	far := NewObstacle(CactusSmall, dinosaur.X+40, obstacleGround, config)
+
+	if !gameEngine.CheckEntityCollision(dinosaur, cactus) {
+		t.Error("Dinosaur should collide with an overlapping cactus")
+	}
+	if gameEngine.CheckEntityCollision(dinosaur, far) {
+		t.Error("Dinosaur should not collide with a distant cactus")
+	}
+
+	// Inactive entities never collide
+	cactus.Deactivate()
+	if gameEngine.CheckEntityCollision(dinosaur, cactus) {
+		t.Error("Deactivated obstacle should not collide")
+	}
+
+	cactus = NewObstacle(CactusSmall, dinosaur.X, obstacleGround, config)
+	dinosaur.Die()
+	if gameEngine.CheckEntityCollision(dinosaur, cactus) {
+		t.Error("Dead dinosaur should not collide")
+	}
+}
@@ -1,7 +1,9 @@
 package entities
 
 import (
+	"cli-dino-game/src/clock"
 	"cli-dino-game/src/engine"
+	"math"
 	"time"
 )
 
@@ -15,18 +17,45 @@ type Dinosaur struct {
 	// State management
 	IsJumping   bool    // Whether the dinosaur is currently jumping
 	IsRunning   bool    // Whether the dinosaur is in running state
+	IsDead      bool    // Whether the dinosaur has been hit
 	AnimFrame   int     // Current animation frame for running
 	GroundLevel float64 // Y position of the ground
 
 	// Animation timing
+	clock          clock.Clock // Time source for animation and jump timing
 	lastAnimUpdate time.Time
 	animSpeed      time.Duration
+	runSpeed       float64   // World speed relative to the base speed; legs move faster as it rises
+	deathTime      time.Time // When the dinosaur was hit
+	squashTime     time.Time // When the dinosaur last took off or landed
 
-	// Dimensions for collision detection
+	// Coyote time: jumps still succeed this long after leaving the ground
+	coyoteWindow     time.Duration
+	lastGroundedTime time.Time
+
+	// Jump buffering: a press this long before landing jumps on landing
+	jumpBufferWindow time.Duration
+	bufferedJumpTime time.Time
+
+	// Dimensions of the sprite
 	Width  float64
 	Height float64
+
+	// Collision box inset inside the sprite, per side
+	hitboxInsetX float64
+	hitboxInsetY float64
+
+	// Event callbacks, for sounds and effects (nil to ignore)
+	OnJump func() // Called when a jump starts, not when a press is ignored
+	OnLand func() // Called when a jump touches back down
 }
 
+// Size of the dinosaur sprite at scale 1
+const (
+	dinoSpriteWidth  = 6.0
+	dinoSpriteHeight = 4.0
+)
+
 // NewDinosaur creates a new dinosaur with default values
 func NewDinosaur(groundLevel float64) *Dinosaur {
 	return &Dinosaur{
@@ -37,25 +66,87 @@ func NewDinosaur(groundLevel float64) *Dinosaur {
 		IsRunning:      true,
 		AnimFrame:      0,
 		GroundLevel:    groundLevel,
-		lastAnimUpdate: time.Now(),
+		clock:          clock.Real,
+		lastAnimUpdate: clock.Real.Now(),
 		animSpeed:      time.Millisecond * 150, // Animation frame duration for smoother 4-frame animation
-		Width:          6.0,                    // Width of dinosaur sprite
-		Height:         4.0,                    // Height of dinosaur sprite
+		runSpeed:       1.0,
+		Width:          dinoSpriteWidth,
+		Height:         dinoSpriteHeight,
 	}
 }
 
-// Jump initiates a jump if the dinosaur is on the ground
+// Jump initiates a jump if the dinosaur is on the ground or just left it
 func (d *Dinosaur) Jump(config *engine.Config) {
-	// Only allow jumping if dinosaur is on the ground
-	if d.IsOnGround() {
+	// Only allow jumping if dinosaur is on the ground, or within the coyote window
+	if d.IsOnGround() || d.clock.Now().Sub(d.lastGroundedTime) < d.coyoteWindow {
 		d.IsJumping = true
-		d.VelocityY = -config.JumpVelocity // Negative because Y increases downward
-		d.IsRunning = false                // Stop running animation while jumping
+		d.VelocityY = -config.GetJumpVelocity() // Negative because Y increases downward
+		d.IsRunning = false                     // Stop running animation while jumping
+		d.lastGroundedTime = time.Time{}        // A jump uses up the coyote window
+		d.bufferedJumpTime = time.Time{}
+		d.squashTime = d.clock.Now()
+		if d.OnJump != nil {
+			d.OnJump()
+		}
+	} else if d.jumpBufferWindow > 0 {
+		// Remember the press so it can fire on landing
+		d.bufferedJumpTime = d.clock.Now()
 	}
 }
 
+// Death animation timing: the dinosaur is stunned, then knocked over
+const (
+	deathStunDuration = 200 * time.Millisecond
+	deathAnimDuration = 600 * time.Millisecond
+)
+
+// squashDuration is how long the dinosaur looks squashed after taking off or landing
+const squashDuration = 100 * time.Millisecond
+
+// IsSquashing returns true briefly after a takeoff or landing. Only the sprite
+// changes; the collision bounds stay the same.
+func (d *Dinosaur) IsSquashing() bool {
+	return !d.squashTime.IsZero() && d.clock.Now().Sub(d.squashTime) < squashDuration
+}
+
+// Die marks the dinosaur as hit and starts the death animation
+func (d *Dinosaur) Die() {
+	d.IsDead = true
+	d.IsRunning = false
+	d.deathTime = d.clock.Now()
+}
+
+// Revive puts a dead dinosaur back on the ground, running
+func (d *Dinosaur) Revive() {
+	d.IsDead = false
+	d.IsJumping = false
+	d.IsRunning = true
+	d.Y = d.GroundLevel
+	d.VelocityY = 0.0
+	d.squashTime = time.Time{}
+	d.ResetAnimation()
+}
+
+// IsDeathAnimating returns true while the death animation is still playing
+func (d *Dinosaur) IsDeathAnimating() bool {
+	return d.IsDead && d.clock.Now().Sub(d.deathTime) < deathAnimDuration
+}
+
+// GetDeathFrame returns 0 while the dinosaur is stunned and 1 once it is knocked over
+func (d *Dinosaur) GetDeathFrame() int {
+	if d.clock.Now().Sub(d.deathTime) < deathStunDuration {
+		return 0
+	}
+	return 1
+}
+
 // Update updates the dinosaur's state and position
 func (d *Dinosaur) Update(deltaTime float64, config *engine.Config) {
+	// A dead dinosaur stays where it was hit
+	if d.IsDead {
+		return
+	}
+
 	// Dinosaur stays in a fixed horizontal position
 	// The world/obstacles will scroll past the dinosaur instead
 	// No horizontal movement needed - X position remains constant
@@ -65,8 +156,12 @@ func (d *Dinosaur) Update(deltaTime float64, config *engine.Config) {
 		// Update vertical position based on current velocity (before applying gravity)
 		d.Y += d.VelocityY * deltaTime
 
-		// Apply gravity to velocity (for next frame)
-		d.VelocityY += config.Gravity * deltaTime
+		// Apply gravity to velocity (for next frame), pulling harder on the way down
+		gravity := config.GetGravity()
+		if d.VelocityY >= 0 {
+			gravity = config.GetFallGravity()
+		}
+		d.VelocityY += gravity * deltaTime
 
 		// Check for landing
 		if d.Y >= d.GroundLevel {
@@ -75,27 +170,70 @@ func (d *Dinosaur) Update(deltaTime float64, config *engine.Config) {
 			d.VelocityY = 0.0
 			d.IsJumping = false
 			d.IsRunning = true // Resume running animation
+			d.lastGroundedTime = d.clock.Now()
+			d.squashTime = d.clock.Now()
+			if d.OnLand != nil {
+				d.OnLand()
+			}
+
+			// Fire a jump pressed shortly before landing
+			if !d.bufferedJumpTime.IsZero() && d.clock.Now().Sub(d.bufferedJumpTime) <= d.jumpBufferWindow {
+				d.Jump(config)
+			}
+			d.bufferedJumpTime = time.Time{}
 		}
 	} else {
+		if d.IsOnGround() {
+			d.lastGroundedTime = d.clock.Now()
+		}
+
 		// Update running animation if on ground
 		if d.IsRunning {
-			now := time.Now()
-			if now.Sub(d.lastAnimUpdate) >= d.animSpeed {
-				d.AnimFrame = (d.AnimFrame + 1) % 4 // Cycle through 4 frames
-				d.lastAnimUpdate = now
-			}
+			d.advanceAnimation()
 		}
 	}
 }
 
-// GetBounds returns the collision rectangle for the dinosaur
+// GetBounds returns the collision rectangle for the dinosaur, inset inside the sprite
 func (d *Dinosaur) GetBounds() engine.Rectangle {
 	return engine.Rectangle{
-		X:      d.X,
-		Y:      d.Y,
-		Width:  d.Width,
-		Height: d.Height,
+		X:      d.X + d.hitboxInsetX,
+		Y:      d.Y + d.hitboxInsetY,
+		Width:  d.Width - 2*d.hitboxInsetX,
+		Height: d.Height - 2*d.hitboxInsetY,
+	}
+}
+
+// IsActive reports whether the dinosaur can still collide, which is while it is alive
+func (d *Dinosaur) IsActive() bool {
+	return !d.IsDead
+}
+
+// SetScale magnifies the dinosaur's size to match sprites drawn at the given scale
+func (d *Dinosaur) SetScale(scale int) {
+	if scale < 1 {
+		scale = 1
 	}
+	d.Width = dinoSpriteWidth * float64(scale)
+	d.Height = DinosaurHeight(scale)
+}
+
+// DinosaurHeight returns how many rows the dinosaur covers at the given sprite scale
+func DinosaurHeight(scale int) float64 {
+	return dinoSpriteHeight * float64(max(scale, 1))
+}
+
+// SetHitboxInset shrinks the collision box inside the sprite by the given amount on
+// each side, so empty sprite corners don't cause collisions. Insets are clamped to
+// keep the box from collapsing past the sprite center.
+func (d *Dinosaur) SetHitboxInset(horizontal, vertical float64) {
+	d.hitboxInsetX = math.Max(0, math.Min(horizontal, d.Width/2))
+	d.hitboxInsetY = math.Max(0, math.Min(vertical, d.Height/2))
+}
+
+// GetHitboxInset returns the horizontal and vertical collision box inset per side
+func (d *Dinosaur) GetHitboxInset() (float64, float64) {
+	return d.hitboxInsetX, d.hitboxInsetY
 }
 
 // GetASCIIArt returns the ASCII art representation of the dinosaur
@@ -105,6 +243,14 @@ func (d *Dinosaur) GetASCIIArt() []string {
 
 // GetASCIIArtWithConfig returns the ASCII art with Unicode/ASCII choice
 func (d *Dinosaur) GetASCIIArtWithConfig(useUnicode bool) []string {
+	if d.IsDead {
+		return d.getDeathArt(useUnicode)
+	}
+
+	if d.IsSquashing() {
+		return d.getSquashArt(useUnicode)
+	}
+
 	if d.IsJumping {
 		if useUnicode {
 			return []string{
@@ -237,6 +383,69 @@ func (d *Dinosaur) SetAnimationSpeed(speed time.Duration) {
 	d.animSpeed = speed
 }
 
+// SetRunSpeed sets how fast the world is scrolling relative to its base speed.
+// The running animation plays that much faster; values of 0 or less play it at
+// the normal rate.
+func (d *Dinosaur) SetRunSpeed(multiplier float64) {
+	if multiplier <= 0 {
+		multiplier = 1.0
+	}
+	d.runSpeed = multiplier
+}
+
+// GetRunSpeed returns the world speed the running animation is matched to
+func (d *Dinosaur) GetRunSpeed() float64 {
+	return d.runSpeed
+}
+
+// frameDuration returns how long each running frame is shown at the current run speed
+func (d *Dinosaur) frameDuration() time.Duration {
+	return time.Duration(float64(d.animSpeed) / d.runSpeed)
+}
+
+// advanceAnimation moves through the running frames for the time passed since the
+// last frame change. Time left over carries to the next update, so the animation
+// rate follows the run speed even when frames are shorter than an update.
+func (d *Dinosaur) advanceAnimation() {
+	interval := d.frameDuration()
+	if interval <= 0 {
+		return
+	}
+
+	frames := int(d.clock.Now().Sub(d.lastAnimUpdate) / interval)
+	if frames <= 0 {
+		return
+	}
+	d.AnimFrame = (d.AnimFrame + frames) % 4 // Cycle through 4 frames
+	d.lastAnimUpdate = d.lastAnimUpdate.Add(time.Duration(frames) * interval)
+}
+
+// SetClock sets the time source for animation and jump timing, restarting the animation timer
+func (d *Dinosaur) SetClock(c clock.Clock) {
+	d.clock = c
+	d.lastAnimUpdate = c.Now()
+}
+
+// SetCoyoteWindow sets how long after leaving the ground a jump still succeeds (0 disables it)
+func (d *Dinosaur) SetCoyoteWindow(window time.Duration) {
+	d.coyoteWindow = window
+}
+
+// GetCoyoteWindow returns how long after leaving the ground a jump still succeeds
+func (d *Dinosaur) GetCoyoteWindow() time.Duration {
+	return d.coyoteWindow
+}
+
+// SetJumpBufferWindow sets how early before landing a jump press is remembered (0 disables it)
+func (d *Dinosaur) SetJumpBufferWindow(window time.Duration) {
+	d.jumpBufferWindow = window
+}
+
+// GetJumpBufferWindow returns how early before landing a jump press is remembered
+func (d *Dinosaur) GetJumpBufferWindow() time.Duration {
+	return d.jumpBufferWindow
+}
+
 // GetAnimationSpeed returns the current animation speed
 func (d *Dinosaur) GetAnimationSpeed() time.Duration {
 	return d.animSpeed
@@ -245,10 +454,64 @@ func (d *Dinosaur) GetAnimationSpeed() time.Duration {
 // ResetAnimation resets the animation to frame 0 and updates the timer
 func (d *Dinosaur) ResetAnimation() {
 	d.AnimFrame = 0
-	d.lastAnimUpdate = time.Now()
+	d.lastAnimUpdate = d.clock.Now()
 }
 
 // IsAnimating returns true if the dinosaur is currently animating (running)
 func (d *Dinosaur) IsAnimating() bool {
 	return d.IsRunning && !d.IsJumping
 }
+
+// getSquashArt returns the flattened sprite shown on takeoff and landing
+func (d *Dinosaur) getSquashArt(useUnicode bool) []string {
+	if useUnicode {
+		return []string{
+			"      ",
+			" ╭───╮",
+			" ╰◉◉─╯",
+			"╰╰  ╰╰",
+		}
+	}
+	return []string{
+		"      ",
+		" #####",
+		" #####",
+		"##  ##",
+	}
+}
+
+// getDeathArt returns the stunned or knocked-over sprite for the death animation
+func (d *Dinosaur) getDeathArt(useUnicode bool) []string {
+	if d.GetDeathFrame() == 0 {
+		if useUnicode {
+			return []string{
+				"  ╭──╮",
+				"  │××│",
+				"  ╰──╯",
+				"╰ ╰╰ ╰",
+			}
+		}
+		return []string{
+			"  ####",
+			"  #xx#",
+			"  ####",
+			"# ## #",
+		}
+	}
+
+	// Knocked over onto its back
+	if useUnicode {
+		return []string{
+			"      ",
+			"╭──╮  ",
+			"│××├╼╼",
+			"╰──╯  ",
+		}
+	}
+	return []string{
+		"      ",
+		"####  ",
+		"#xx#==",
+		"####  ",
+	}
+}
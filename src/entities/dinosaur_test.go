@@ -1,7 +1,10 @@
 package entities
 
 import (
+	"cli-dino-game/src/clock"
 	"cli-dino-game/src/engine"
+	"math"
+	"strings"
 	"testing"
 	"time"
 )
@@ -127,6 +130,57 @@ func TestDinosaurGetBounds(t *testing.T) {
 	}
 }
 
+func TestDinosaurHitboxInset(t *testing.T) {
+	groundLevel := 15.0
+	dino := NewDinosaur(groundLevel)
+	dino.SetHitboxInset(0.5, 0.5)
+
+	bounds := dino.GetBounds()
+	if bounds.X != dino.X+0.5 || bounds.Y != dino.Y+0.5 {
+		t.Errorf("Expected hitbox origin inset by 0.5, got %s", bounds)
+	}
+	if bounds.Width != dino.Width-1.0 || bounds.Height != dino.Height-1.0 {
+		t.Errorf("Expected hitbox %.1fx%.1f, got %s", dino.Width-1.0, dino.Height-1.0, bounds)
+	}
+
+	// An obstacle just touching the sprite's corner no longer collides
+	cd := engine.NewCollisionDetector()
+	sprite := engine.Rectangle{X: dino.X, Y: dino.Y, Width: dino.Width, Height: dino.Height}
+	nearEdge := engine.Rectangle{X: dino.X + dino.Width - 0.25, Y: dino.Y + dino.Height - 0.25, Width: 3, Height: 3}
+	if !cd.CheckCollision(sprite, nearEdge) {
+		t.Fatal("Expected near-edge obstacle to overlap the full sprite")
+	}
+	if cd.CheckCollision(bounds, nearEdge) {
+		t.Error("Expected near-edge obstacle to miss the inset hitbox")
+	}
+
+	// Insets are clamped
+	dino.SetHitboxInset(-1, 100)
+	x, y := dino.GetHitboxInset()
+	if x != 0 || y != dino.Height/2 {
+		t.Errorf("Expected insets clamped to 0 and %.1f, got %.1f and %.1f", dino.Height/2, x, y)
+	}
+}
+
+func TestDinosaurSetScale(t *testing.T) {
+	dino := NewDinosaur(15.0)
+	unscaled := dino.GetBounds()
+
+	dino.SetScale(2)
+	bounds := dino.GetBounds()
+	if bounds.Width != unscaled.Width*2 || bounds.Height != unscaled.Height*2 {
+		t.Errorf("Expected bounds doubled from %s, got %s", unscaled, bounds)
+	}
+	if bounds.X != unscaled.X || bounds.Y != unscaled.Y {
+		t.Errorf("Expected scaling to keep the origin, got %s", bounds)
+	}
+
+	dino.SetScale(0)
+	if dino.GetBounds() != unscaled {
+		t.Errorf("Expected scale below 1 to restore the unscaled size, got %s", dino.GetBounds())
+	}
+}
+
 func TestDinosaurGetASCIIArt_Running(t *testing.T) {
 	dino := NewDinosaur(15.0)
 	dino.IsJumping = false
@@ -317,6 +371,162 @@ func TestDinosaurJump_WhenAboveGround(t *testing.T) {
 	}
 }
 
+func TestDinosaurOnJump(t *testing.T) {
+	groundLevel := 15.0
+	config := &engine.Config{Gravity: 50.0, JumpVelocity: 15.0}
+
+	tests := []struct {
+		name     string
+		setup    func(d *Dinosaur)
+		expected int
+	}{
+		{"from the ground", func(d *Dinosaur) {}, 1},
+		{"already jumping", func(d *Dinosaur) {
+			d.IsJumping = true
+			d.VelocityY = -10.0
+		}, 0},
+		{"above ground", func(d *Dinosaur) {
+			d.Y = groundLevel - 5.0
+		}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dino := NewDinosaur(groundLevel)
+			jumps := 0
+			dino.OnJump = func() { jumps++ }
+			tt.setup(dino)
+
+			dino.Jump(config)
+			if jumps != tt.expected {
+				t.Errorf("Expected OnJump to fire %d times, got %d", tt.expected, jumps)
+			}
+		})
+	}
+
+	// A second press mid-air does not fire again
+	dino := NewDinosaur(groundLevel)
+	jumps := 0
+	dino.OnJump = func() { jumps++ }
+	dino.Jump(config)
+	dino.Update(0.05, config)
+	dino.Jump(config)
+	if jumps != 1 {
+		t.Errorf("Expected one jump event for a ground jump and a mid-air press, got %d", jumps)
+	}
+
+	// Without a callback jumping still works
+	dino = NewDinosaur(groundLevel)
+	dino.Jump(config)
+	if !dino.IsJumping {
+		t.Error("Expected the dinosaur to jump without a callback")
+	}
+}
+
+func TestDinosaurJump_CoyoteTime(t *testing.T) {
+	groundLevel := 15.0
+	config := &engine.Config{Gravity: 50.0, JumpVelocity: 15.0}
+
+	tests := []struct {
+		name         string
+		window       time.Duration
+		sinceGround  time.Duration
+		expectedJump bool
+	}{
+		{"disabled by default", 0, time.Millisecond, false},
+		{"within window", 100 * time.Millisecond, 10 * time.Millisecond, true},
+		{"outside window", 100 * time.Millisecond, 200 * time.Millisecond, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dino := NewDinosaur(groundLevel)
+			dino.SetCoyoteWindow(tt.window)
+
+			// Just left the ground without jumping
+			dino.Y = groundLevel - 1.0
+			dino.lastGroundedTime = time.Now().Add(-tt.sinceGround)
+
+			dino.Jump(config)
+			if dino.IsJumping != tt.expectedJump {
+				t.Errorf("Expected IsJumping %v, got %v", tt.expectedJump, dino.IsJumping)
+			}
+		})
+	}
+}
+
+func TestDinosaurJump_CoyoteTimeNoDoubleJump(t *testing.T) {
+	groundLevel := 15.0
+	dino := NewDinosaur(groundLevel)
+	dino.SetCoyoteWindow(time.Second)
+	config := &engine.Config{Gravity: 50.0, JumpVelocity: 15.0}
+
+	if dino.GetCoyoteWindow() != time.Second {
+		t.Errorf("Expected coyote window 1s, got %v", dino.GetCoyoteWindow())
+	}
+
+	// Run a frame on the ground, then jump
+	dino.Update(0.016, config)
+	dino.Jump(config)
+	dino.Update(0.1, config)
+
+	// A second press mid-air must not jump again
+	velocity := dino.VelocityY
+	dino.Jump(config)
+	if dino.VelocityY != velocity {
+		t.Errorf("Expected coyote time not to allow a double jump, velocity changed from %f to %f", velocity, dino.VelocityY)
+	}
+}
+
+func TestDinosaurJump_InputBuffer(t *testing.T) {
+	groundLevel := 15.0
+	config := &engine.Config{Gravity: 50.0, JumpVelocity: 15.0}
+
+	tests := []struct {
+		name         string
+		window       time.Duration
+		pressedAgo   time.Duration
+		expectedJump bool
+	}{
+		{"disabled by default", 0, 0, false},
+		{"press within window", 100 * time.Millisecond, 20 * time.Millisecond, true},
+		{"press outside window", 100 * time.Millisecond, 300 * time.Millisecond, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dino := NewDinosaur(groundLevel)
+			dino.SetJumpBufferWindow(tt.window)
+
+			// Falling just above the ground
+			dino.IsJumping = true
+			dino.Y = groundLevel - 0.1
+			dino.VelocityY = 5.0
+
+			// Press jump while airborne
+			dino.Jump(config)
+			if dino.VelocityY != 5.0 {
+				t.Fatal("A press while airborne should not jump immediately")
+			}
+			if !dino.bufferedJumpTime.IsZero() {
+				dino.bufferedJumpTime = time.Now().Add(-tt.pressedAgo)
+			}
+
+			// Land
+			dino.Update(0.1, config)
+			if dino.IsJumping != tt.expectedJump {
+				t.Errorf("Expected IsJumping %v after landing, got %v", tt.expectedJump, dino.IsJumping)
+			}
+			if tt.expectedJump && dino.VelocityY != -config.JumpVelocity {
+				t.Errorf("Expected buffered jump velocity %f, got %f", -config.JumpVelocity, dino.VelocityY)
+			}
+			if !dino.bufferedJumpTime.IsZero() {
+				t.Error("Buffered press should be cleared on landing")
+			}
+		})
+	}
+}
+
 func TestDinosaurUpdate_JumpPhysics(t *testing.T) {
 	groundLevel := 15.0
 	dino := NewDinosaur(groundLevel)
@@ -377,6 +587,52 @@ func TestDinosaurUpdate_Landing(t *testing.T) {
 	}
 }
 
+func TestDinosaurOnLand(t *testing.T) {
+	dino := NewDinosaur(15.0)
+	config := &engine.Config{Gravity: 50.0, JumpVelocity: 15.0}
+	lands := 0
+	dino.OnLand = func() { lands++ }
+
+	// Running along the ground never lands
+	for i := 0; i < 20; i++ {
+		dino.Update(0.05, config)
+	}
+	if lands != 0 {
+		t.Errorf("Expected no landings while running, got %d", lands)
+	}
+
+	for jump := 1; jump <= 3; jump++ {
+		dino.Jump(config)
+		for i := 0; i < 100 && dino.IsJumping; i++ {
+			dino.Update(0.05, config)
+			if dino.IsJumping && lands != jump-1 {
+				t.Fatalf("Expected no landing mid-air on jump %d", jump)
+			}
+		}
+		if dino.IsJumping {
+			t.Fatal("Expected the dinosaur to land")
+		}
+
+		// Keep running after touching down
+		for i := 0; i < 5; i++ {
+			dino.Update(0.05, config)
+		}
+		if lands != jump {
+			t.Errorf("Expected %d landings after %d jumps, got %d", jump, jump, lands)
+		}
+	}
+
+	// Without a callback landing still works
+	dino.OnLand = nil
+	dino.Jump(config)
+	for i := 0; i < 100 && dino.IsJumping; i++ {
+		dino.Update(0.05, config)
+	}
+	if dino.IsJumping {
+		t.Error("Expected the dinosaur to land without a callback")
+	}
+}
+
 func TestDinosaurUpdate_JumpArc(t *testing.T) {
 	groundLevel := 15.0
 	dino := NewDinosaur(groundLevel)
@@ -556,13 +812,15 @@ func TestDinosaurAnimationFrameCycling(t *testing.T) {
 func TestDinosaurAnimationTiming(t *testing.T) {
 	dino := NewDinosaur(15.0)
 	config := &engine.Config{Gravity: 50.0, JumpVelocity: 15.0}
+	fake := clock.NewFakeClock(time.Now())
+	dino.SetClock(fake)
 
 	dino.IsRunning = true
 	dino.IsJumping = false
 	initialFrame := dino.AnimFrame
 
 	// Update with insufficient time elapsed - frame should not change
-	dino.lastAnimUpdate = time.Now().Add(-time.Millisecond * 50) // Less than animSpeed (150ms)
+	fake.Advance(time.Millisecond * 50) // Less than animSpeed (150ms)
 	dino.Update(0.1, config)
 
 	if dino.AnimFrame != initialFrame {
@@ -570,7 +828,7 @@ func TestDinosaurAnimationTiming(t *testing.T) {
 	}
 
 	// Update with sufficient time elapsed - frame should change
-	dino.lastAnimUpdate = time.Now().Add(-time.Millisecond * 200) // More than animSpeed (150ms)
+	fake.Advance(time.Millisecond * 150) // 200ms in total, more than animSpeed (150ms)
 	dino.Update(0.1, config)
 
 	if dino.AnimFrame == initialFrame {
@@ -578,6 +836,85 @@ func TestDinosaurAnimationTiming(t *testing.T) {
 	}
 }
 
+// framesAdvanced counts running frame changes over a second of 15 FPS updates
+func framesAdvanced(runSpeed float64) int {
+	dino := NewDinosaur(15.0)
+	config := &engine.Config{Gravity: 50.0, JumpVelocity: 15.0}
+	fake := clock.NewFakeClock(time.Now())
+	dino.SetClock(fake)
+	dino.SetRunSpeed(runSpeed)
+
+	advanced := 0
+	for i := 0; i < 15; i++ {
+		before := dino.AnimFrame
+		fake.Advance(time.Second / 15)
+		dino.Update(1.0/15, config)
+		advanced += (dino.AnimFrame - before + 4) % 4
+	}
+	return advanced
+}
+
+func TestDinosaurRunSpeedScalesAnimation(t *testing.T) {
+	base := framesAdvanced(1.0)
+	doubled := framesAdvanced(2.0)
+
+	if base == 0 {
+		t.Fatal("Expected the running animation to advance at the base speed")
+	}
+	if doubled < 2*base-1 || doubled > 2*base+1 {
+		t.Errorf("Expected doubling the world speed to roughly double the frames per second, got %d then %d", base, doubled)
+	}
+
+	// A stopped or invalid speed plays at the normal rate
+	dino := NewDinosaur(15.0)
+	dino.SetRunSpeed(0)
+	if dino.GetRunSpeed() != 1.0 {
+		t.Errorf("Expected a non-positive run speed to reset to 1, got %f", dino.GetRunSpeed())
+	}
+}
+
+func TestDinosaurSquashOnTakeoffAndLanding(t *testing.T) {
+	dino := NewDinosaur(15.0)
+	config := &engine.Config{Gravity: 50.0, JumpVelocity: 15.0}
+	fake := clock.NewFakeClock(time.Now())
+	dino.SetClock(fake)
+	squash := dino.getSquashArt(false)
+
+	if dino.IsSquashing() {
+		t.Error("Expected no squash before the first jump")
+	}
+
+	dino.Jump(config)
+	bounds := dino.GetBounds()
+	if !dino.IsSquashing() || dino.GetASCIIArt()[1] != squash[1] {
+		t.Error("Expected the squash sprite right after takeoff")
+	}
+	if dino.GetBounds() != bounds || dino.GetBounds().Height != dino.Height {
+		t.Error("Expected the squash not to change the collision bounds")
+	}
+
+	fake.Advance(squashDuration)
+	if dino.IsSquashing() || dino.GetASCIIArt()[1] == squash[1] {
+		t.Error("Expected the sprite to revert once the squash has played")
+	}
+
+	// Fall back to the ground
+	for i := 0; i < 100 && dino.IsJumping; i++ {
+		dino.Update(0.05, config)
+	}
+	if dino.IsJumping {
+		t.Fatal("Expected the dinosaur to land")
+	}
+	if !dino.IsSquashing() || dino.GetASCIIArt()[1] != squash[1] {
+		t.Error("Expected the squash sprite right after landing")
+	}
+
+	fake.Advance(squashDuration)
+	if dino.IsSquashing() {
+		t.Error("Expected the landing squash to end")
+	}
+}
+
 func TestDinosaurGetAnimationFrame(t *testing.T) {
 	dino := NewDinosaur(15.0)
 
@@ -602,12 +939,15 @@ func TestDinosaurSetAnimationSpeed(t *testing.T) {
 func TestDinosaurResetAnimation(t *testing.T) {
 	dino := NewDinosaur(15.0)
 
+	fake := clock.NewFakeClock(time.Now())
+	dino.SetClock(fake)
+
 	// Set to non-zero frame
 	dino.AnimFrame = 3
 	oldTime := dino.lastAnimUpdate
 
-	// Wait a bit to ensure time difference
-	time.Sleep(time.Millisecond * 10)
+	// Move time on to ensure a difference
+	fake.Advance(time.Millisecond * 10)
 
 	dino.ResetAnimation()
 
@@ -722,3 +1062,112 @@ func TestDinosaurAnimationFrameOutOfBounds(t *testing.T) {
 		}
 	}
 }
+
+func TestDinosaurDeathArt(t *testing.T) {
+	dino := NewDinosaur(15.0)
+	fake := clock.NewFakeClock(time.Now())
+	dino.SetClock(fake)
+
+	for _, useUnicode := range []bool{true, false} {
+		dino.Revive()
+		runningArt := dino.GetASCIIArtWithConfig(useUnicode)
+		dino.IsJumping = true
+		jumpingArt := dino.GetASCIIArtWithConfig(useUnicode)
+		dino.IsJumping = false
+
+		dino.Die()
+		if !dino.IsDeathAnimating() {
+			t.Error("Expected death animation to play right after dying")
+		}
+		stunnedArt := dino.GetASCIIArtWithConfig(useUnicode)
+
+		// Skip ahead to the knocked-over frame
+		fake.Advance(deathAnimDuration)
+		if dino.IsDeathAnimating() {
+			t.Error("Expected death animation to finish after its duration")
+		}
+		knockedArt := dino.GetASCIIArtWithConfig(useUnicode)
+
+		for name, art := range map[string][]string{"stunned": stunnedArt, "knocked over": knockedArt} {
+			if len(art) != 4 {
+				t.Errorf("Expected %s art to have 4 lines, got %d", name, len(art))
+			}
+			if strings.Join(art, "\n") == strings.Join(runningArt, "\n") {
+				t.Errorf("Expected %s art to differ from running art", name)
+			}
+			if strings.Join(art, "\n") == strings.Join(jumpingArt, "\n") {
+				t.Errorf("Expected %s art to differ from jumping art", name)
+			}
+		}
+		if strings.Join(stunnedArt, "\n") == strings.Join(knockedArt, "\n") {
+			t.Error("Expected the death animation to have distinct frames")
+		}
+	}
+}
+
+func TestDinosaurDieAndRevive(t *testing.T) {
+	groundLevel := 15.0
+	dino := NewDinosaur(groundLevel)
+	config := &engine.Config{Gravity: 50.0, JumpVelocity: 15.0}
+
+	dino.Jump(config)
+	dino.Update(0.1, config)
+	dino.Die()
+
+	// A dead dinosaur freezes in place
+	y := dino.Y
+	dino.Update(0.1, config)
+	if dino.Y != y {
+		t.Errorf("Expected dead dinosaur to stay at %f, got %f", y, dino.Y)
+	}
+
+	dino.Revive()
+	if dino.IsDead || !dino.IsOnGround() || !dino.IsRunning {
+		t.Error("Expected revived dinosaur to be running on the ground")
+	}
+}
+
+// jumpTimes simulates a jump in small steps and returns how long the dinosaur
+// rises and how long it falls back to the ground
+func jumpTimes(config *engine.Config) (rise, fall float64) {
+	dino := NewDinosaur(15.0)
+	dino.Jump(config)
+
+	const dt = 0.0005
+	elapsed := 0.0
+	for dino.IsJumping && elapsed < 10 {
+		if dino.VelocityY < 0 {
+			rise += dt
+		} else {
+			fall += dt
+		}
+		dino.Update(dt, config)
+		elapsed += dt
+	}
+	return rise, fall
+}
+
+func TestDinosaurFallGravityMultiplier(t *testing.T) {
+	// The default multiplier keeps jumps symmetric
+	config := engine.NewDefaultConfig()
+	rise, fall := jumpTimes(config)
+	if math.Abs(rise-fall) > 0.01 {
+		t.Errorf("Expected equal rise and fall times, got %.3fs up and %.3fs down", rise, fall)
+	}
+
+	// An unset multiplier behaves like 1
+	config.FallGravityMultiplier = 0
+	if r, f := jumpTimes(config); math.Abs(r-rise) > 1e-9 || math.Abs(f-fall) > 1e-9 {
+		t.Errorf("Expected an unset multiplier to match 1.0, got %.3fs up and %.3fs down", r, f)
+	}
+
+	// Falling twice as hard covers the same height in 1/sqrt(2) of the time
+	config.FallGravityMultiplier = 2.0
+	fastRise, fastFall := jumpTimes(config)
+	if math.Abs(fastRise-rise) > 0.01 {
+		t.Errorf("Expected the rise to be unchanged, got %.3fs instead of %.3fs", fastRise, rise)
+	}
+	if want := rise / math.Sqrt2; math.Abs(fastFall-want) > 0.01 {
+		t.Errorf("Expected a fall of about %.3fs, got %.3fs", want, fastFall)
+	}
+}
@@ -1,7 +1,11 @@
 package entities
 
 import (
+	"cli-dino-game/src/clock"
 	"cli-dino-game/src/engine"
+	"fmt"
+	"math"
+	"strings"
 	"time"
 )
 
@@ -37,6 +41,149 @@ func (ot ObstacleType) String() string {
 	}
 }
 
+// ParseObstacleType returns the obstacle type with the given name, either as
+// String returns it or hyphenated, such as "cactus-large"
+func ParseObstacleType(name string) (ObstacleType, error) {
+	normalized := strings.ReplaceAll(name, "-", "")
+	for _, obstType := range allObstacleTypes {
+		if strings.EqualFold(normalized, obstType.String()) {
+			return obstType, nil
+		}
+	}
+	return CactusSmall, fmt.Errorf("unknown obstacle type %q (want cactus-small, cactus-medium, cactus-large, bird-low, bird-mid, or bird-high)", name)
+}
+
+// ObstacleDimension is the collision box of an obstacle type. YOffset is how far
+// the top of the box sits above the ground line.
+type ObstacleDimension struct {
+	W       float64
+	H       float64
+	YOffset float64
+}
+
+// defaultObstacleDimensions holds the built-in collision boxes
+var defaultObstacleDimensions = map[ObstacleType]ObstacleDimension{
+	CactusSmall:  {W: 3.0, H: 3.0, YOffset: 3.0}, // Cacti sit on the ground
+	CactusMedium: {W: 3.0, H: 4.0, YOffset: 4.0},
+	CactusLarge:  {W: 5.0, H: 5.0, YOffset: 5.0},
+	// Birds use their full sprite size. The dinosaur occupies the 4 rows above
+	// the ground line, so these offsets put birds at its lower body, middle, and head.
+	BirdLow:  {W: 4.0, H: 2.0, YOffset: 3.0},
+	BirdMid:  {W: 4.0, H: 2.0, YOffset: 4.0},
+	BirdHigh: {W: 4.0, H: 2.0, YOffset: 5.0},
+}
+
+// allObstacleTypes lists every obstacle type, smallest cactus first
+var allObstacleTypes = []ObstacleType{CactusSmall, CactusMedium, CactusLarge, BirdLow, BirdMid, BirdHigh}
+
+// obstacleDisplayNames holds the names shown to players
+var obstacleDisplayNames = map[ObstacleType]string{
+	CactusSmall:  "Small cactus",
+	CactusMedium: "Medium cactus",
+	CactusLarge:  "Large cactus",
+	BirdLow:      "Low bird",
+	BirdMid:      "Mid bird",
+	BirdHigh:     "High bird",
+}
+
+// DisplayName returns the name shown to players for an obstacle type
+func (ot ObstacleType) DisplayName() string {
+	if name, ok := obstacleDisplayNames[ot]; ok {
+		return name
+	}
+	return ot.String()
+}
+
+// ObstacleInfo describes an obstacle type for help screens and tools
+type ObstacleInfo struct {
+	Type ObstacleType
+	Name string
+	ObstacleDimension
+
+	Jumpable bool // A jump with the default physics clears its top
+	Duckable bool // It passes over the head of a dinosaur on the ground
+}
+
+// AllObstacleTypes returns every obstacle type with its name, collision box, and
+// how the dinosaur gets past it with the default physics
+func AllObstacleTypes() []ObstacleInfo {
+	config := engine.NewDefaultConfig()
+
+	infos := make([]ObstacleInfo, 0, len(allObstacleTypes))
+	for _, obstType := range allObstacleTypes {
+		infos = append(infos, ObstacleInfo{
+			Type:              obstType,
+			Name:              obstType.DisplayName(),
+			ObstacleDimension: obstacleDimensions[obstType],
+			Jumpable:          IsJumpable(obstType, config),
+			Duckable:          IsDuckable(obstType, config),
+		})
+	}
+	return infos
+}
+
+// IsJumpable reports whether a jump with the config's physics rises above the
+// top of an obstacle type
+func IsJumpable(obstType ObstacleType, config *engine.Config) bool {
+	dim, ok := obstacleDimensions[obstType]
+	if !ok || config.Gravity <= 0 {
+		return false
+	}
+
+	return JumpHeight(config) >= dim.YOffset*float64(config.GetSpriteScale())
+}
+
+// JumpHeight returns how high above the ground a jump with the config's physics
+// rises, or 0 without gravity
+func JumpHeight(config *engine.Config) float64 {
+	if config.Gravity <= 0 {
+		return 0
+	}
+	// At the peak the upward velocity is spent: v^2 / 2g
+	return config.GetJumpVelocity() * config.GetJumpVelocity() / (2 * config.GetGravity())
+}
+
+// IsDuckable reports whether an obstacle type passes over the head of a
+// dinosaur on the ground
+func IsDuckable(obstType ObstacleType, config *engine.Config) bool {
+	dim, ok := obstacleDimensions[obstType]
+	if !ok {
+		return false
+	}
+
+	// The obstacle and the dinosaur are magnified alike
+	scale := float64(config.GetSpriteScale())
+	return (dim.YOffset-dim.H)*scale >= dinoSpriteHeight*scale
+}
+
+// obstacleDimensions holds the collision boxes NewObstacle uses
+var obstacleDimensions = copyObstacleDimensions(defaultObstacleDimensions)
+
+// copyObstacleDimensions returns a copy of a dimension table
+func copyObstacleDimensions(dims map[ObstacleType]ObstacleDimension) map[ObstacleType]ObstacleDimension {
+	copied := make(map[ObstacleType]ObstacleDimension, len(dims))
+	for obstType, dim := range dims {
+		copied[obstType] = dim
+	}
+	return copied
+}
+
+// SetObstacleDimension overrides the collision box used for new obstacles of a type
+func SetObstacleDimension(obstType ObstacleType, dim ObstacleDimension) {
+	obstacleDimensions[obstType] = dim
+}
+
+// GetObstacleDimension returns the collision box used for new obstacles of a type
+func GetObstacleDimension(obstType ObstacleType) (ObstacleDimension, bool) {
+	dim, ok := obstacleDimensions[obstType]
+	return dim, ok
+}
+
+// ResetObstacleDimensions restores the built-in collision boxes
+func ResetObstacleDimensions() {
+	obstacleDimensions = copyObstacleDimensions(defaultObstacleDimensions)
+}
+
 // Obstacle represents an obstacle that the dinosaur must avoid
 type Obstacle struct {
 	// Position and movement
@@ -46,64 +193,58 @@ type Obstacle struct {
 
 	// Obstacle properties
 	ObstType ObstacleType // Type of obstacle
-	Width    float64      // Width for collision detection
-	Height   float64      // Height for collision detection
+	Width    float64      // Width of the sprite area
+	Height   float64      // Height of the sprite area
+
+	// hitboxInset shrinks the collision box inside the sprite area on every side
+	hitboxInset float64
 
 	// Animation (for birds)
 	AnimFrame      int           // Current animation frame
+	clock          clock.Clock   // Time source for animation
 	lastAnimUpdate time.Time     // Last animation update time
 	animSpeed      time.Duration // Animation frame duration
 
 	// State
 	Active bool // Whether the obstacle is active (on screen)
+	Scored bool // Whether the passing bonus has already been awarded
 }
 
 // NewObstacle creates a new obstacle of the specified type
 func NewObstacle(obstType ObstacleType, x, groundLevel float64, config *engine.Config) *Obstacle {
-	obstacle := &Obstacle{
+	obstacle := &Obstacle{clock: clock.Real}
+	obstacle.Reset(obstType, x, groundLevel, config)
+	return obstacle
+}
+
+// Reset reinitializes every field as NewObstacle does so a retired obstacle can be
+// reused. The obstacle keeps its clock and restarts the animation timer from it.
+func (o *Obstacle) Reset(obstType ObstacleType, x, groundLevel float64, config *engine.Config) {
+	c := o.clock
+	if c == nil {
+		c = clock.Real
+	}
+
+	*o = Obstacle{
 		X:              x,
 		Y:              groundLevel,
-		Speed:          config.ObstacleSpeed,
+		Speed:          config.GetObstacleSpeed(),
+		hitboxInset:    config.ObstacleHitboxInset * float64(config.GetSpriteScale()),
 		ObstType:       obstType,
 		Active:         true,
 		AnimFrame:      0,
-		lastAnimUpdate: time.Now(),
+		clock:          c,
+		lastAnimUpdate: c.Now(),
 		animSpeed:      time.Millisecond * 200, // Wing flapping speed
 	}
 
-	// Set dimensions based on obstacle type
-	switch obstType {
-	case CactusSmall:
-		obstacle.Width = 3.0
-		obstacle.Height = 3.0
-		obstacle.Y = groundLevel - obstacle.Height // Adjust Y to sit on ground
-	case CactusMedium:
-		obstacle.Width = 3.0
-		obstacle.Height = 4.0
-		obstacle.Y = groundLevel - obstacle.Height
-	case CactusLarge:
-		obstacle.Width = 5.0
-		obstacle.Height = 5.0
-		obstacle.Y = groundLevel - obstacle.Height
-	case BirdLow:
-		obstacle.Width = 4.0  // Use full sprite width for more realistic collision
-		obstacle.Height = 2.0 // Use full sprite height for more realistic collision
-		// groundLevel passed here is actualGroundY (where ground line is drawn)
-		// Dinosaur is at (groundLevel - dinosaur.Height), which is (groundLevel - 4)
-		// So dinosaur occupies Y from (groundLevel - 4) to groundLevel
-		// BirdLow should be at dinosaur's lower body level
-		obstacle.Y = groundLevel - 3.0 // Bird at dinosaur lower body level
-	case BirdMid:
-		obstacle.Width = 4.0           // Use full sprite width
-		obstacle.Height = 2.0          // Use full sprite height
-		obstacle.Y = groundLevel - 4.0 // Bird at dinosaur middle body level
-	case BirdHigh:
-		obstacle.Width = 4.0           // Use full sprite width
-		obstacle.Height = 2.0          // Use full sprite height
-		obstacle.Y = groundLevel - 5.0 // Bird at dinosaur head level
+	// Set dimensions based on obstacle type, magnified with the sprites
+	if dim, ok := obstacleDimensions[obstType]; ok {
+		scale := float64(config.GetSpriteScale())
+		o.Width = dim.W * scale
+		o.Height = dim.H * scale
+		o.Y = groundLevel - dim.YOffset*scale
 	}
-
-	return obstacle
 }
 
 // Update updates the obstacle's position and state
@@ -112,12 +253,25 @@ func (o *Obstacle) Update(deltaTime float64) {
 		return
 	}
 
+	var now time.Time
+	if o.isBird() {
+		now = o.clock.Now()
+	}
+	o.UpdateAt(deltaTime, now)
+}
+
+// UpdateAt updates the obstacle like Update, animating birds as of now instead of
+// reading the clock. The spawner reads the clock once per frame for all obstacles.
+func (o *Obstacle) UpdateAt(deltaTime float64, now time.Time) {
+	if !o.Active {
+		return
+	}
+
 	// Move obstacle from right to left
 	o.X -= o.Speed * deltaTime
 
 	// Update animation for birds
 	if o.isBird() {
-		now := time.Now()
 		if now.Sub(o.lastAnimUpdate) >= o.animSpeed {
 			o.AnimFrame = (o.AnimFrame + 1) % 2 // Birds have 2 animation frames
 			o.lastAnimUpdate = now
@@ -137,6 +291,19 @@ func (o *Obstacle) isBird() bool {
 
 // GetBounds returns the collision rectangle for the obstacle
 func (o *Obstacle) GetBounds() engine.Rectangle {
+	// Never inset past the center of the sprite
+	inset := math.Min(o.hitboxInset, math.Min(o.Width, o.Height)/2)
+
+	return engine.Rectangle{
+		X:      o.X + inset,
+		Y:      o.Y + inset,
+		Width:  o.Width - 2*inset,
+		Height: o.Height - 2*inset,
+	}
+}
+
+// GetSpriteBounds returns the full area the obstacle's sprite covers
+func (o *Obstacle) GetSpriteBounds() engine.Rectangle {
 	return engine.Rectangle{
 		X:      o.X,
 		Y:      o.Y,
@@ -145,6 +312,19 @@ func (o *Obstacle) GetBounds() engine.Rectangle {
 	}
 }
 
+// SetHitboxInset sets how far the collision box is shrunk inside the sprite on each side
+func (o *Obstacle) SetHitboxInset(inset float64) {
+	if inset < 0 {
+		inset = 0
+	}
+	o.hitboxInset = inset
+}
+
+// GetHitboxInset returns how far the collision box is shrunk inside the sprite on each side
+func (o *Obstacle) GetHitboxInset() float64 {
+	return o.hitboxInset
+}
+
 // GetASCIIArt returns the ASCII art representation of the obstacle
 func (o *Obstacle) GetASCIIArt() []string {
 	return o.GetASCIIArtWithConfig(false) // Default to ASCII
@@ -239,6 +419,12 @@ func (o *Obstacle) GetASCIIArtWithConfig(useUnicode bool) []string {
 	}
 }
 
+// SetClock sets the time source for animation, restarting the animation timer
+func (o *Obstacle) SetClock(c clock.Clock) {
+	o.clock = c
+	o.lastAnimUpdate = c.Now()
+}
+
 // GetPosition returns the current position of the obstacle
 func (o *Obstacle) GetPosition() (float64, float64) {
 	return o.X, o.Y
@@ -260,6 +446,16 @@ func (o *Obstacle) Deactivate() {
 	o.Active = false
 }
 
+// IsScored returns whether the passing bonus has already been awarded for this obstacle
+func (o *Obstacle) IsScored() bool {
+	return o.Scored
+}
+
+// MarkScored records that the passing bonus has been awarded for this obstacle
+func (o *Obstacle) MarkScored() {
+	o.Scored = true
+}
+
 // GetType returns the obstacle type
 func (o *Obstacle) GetType() ObstacleType {
 	return o.ObstType
@@ -275,6 +471,18 @@ func (o *Obstacle) SetSpeed(speed float64) {
 	o.Speed = speed
 }
 
+// TimeToReach returns how long until the obstacle's left edge reaches x.
+// It is zero once the edge is at or past x, and the maximum duration if the obstacle is not moving.
+func (o *Obstacle) TimeToReach(x float64) time.Duration {
+	if o.X <= x {
+		return 0
+	}
+	if o.Speed <= 0 {
+		return time.Duration(math.MaxInt64)
+	}
+	return time.Duration((o.X - x) / o.Speed * float64(time.Second))
+}
+
 // IsOffScreen returns true if the obstacle has moved completely off the left side of the screen
 func (o *Obstacle) IsOffScreen() bool {
 	return o.X+o.Width < 0
@@ -1,8 +1,11 @@
 package entities
 
 import (
+	"cli-dino-game/src/clock"
 	"cli-dino-game/src/engine"
+	"reflect"
 	"testing"
+	"time"
 )
 
 func TestNewObstacle(t *testing.T) {
@@ -136,6 +139,100 @@ func TestObstacleGetBounds(t *testing.T) {
 	}
 }
 
+func TestObstacleDimensionOverride(t *testing.T) {
+	config := engine.NewDefaultConfig()
+	groundLevel := 15.0
+	defer ResetObstacleDimensions()
+
+	before := NewObstacle(BirdMid, 80.0, groundLevel, config).GetBounds()
+	if before.Width != 4.0 || before.Height != 2.0 || before.Y != groundLevel-4.0 {
+		t.Errorf("Expected default BirdMid bounds 4x2 at %f, got %s", groundLevel-4.0, before)
+	}
+
+	// Shrink the bird's collision box inside its sprite
+	SetObstacleDimension(BirdMid, ObstacleDimension{W: 2.0, H: 1.0, YOffset: 3.5})
+	bounds := NewObstacle(BirdMid, 80.0, groundLevel, config).GetBounds()
+	if bounds.Width != 2.0 || bounds.Height != 1.0 || bounds.Y != groundLevel-3.5 {
+		t.Errorf("Expected overridden BirdMid bounds 2x1 at %f, got %s", groundLevel-3.5, bounds)
+	}
+
+	// Other types keep their defaults
+	cactus := NewObstacle(CactusLarge, 80.0, groundLevel, config).GetBounds()
+	if cactus.Width != 5.0 || cactus.Height != 5.0 || cactus.Y != groundLevel-5.0 {
+		t.Errorf("Expected default CactusLarge bounds, got %s", cactus)
+	}
+
+	ResetObstacleDimensions()
+	if dim, _ := GetObstacleDimension(BirdMid); dim != defaultObstacleDimensions[BirdMid] {
+		t.Errorf("Expected reset to restore defaults, got %+v", dim)
+	}
+}
+
+func TestObstacleHitboxInset(t *testing.T) {
+	config := engine.NewDefaultConfig()
+	groundLevel := 15.0
+
+	// No inset by default: hitbox matches the sprite
+	obstacle := NewObstacle(CactusLarge, 80.0, groundLevel, config)
+	if obstacle.GetBounds() != obstacle.GetSpriteBounds() {
+		t.Errorf("Expected hitbox to match sprite without inset, got %s vs %s", obstacle.GetBounds(), obstacle.GetSpriteBounds())
+	}
+
+	// The config inset applies to new obstacles
+	config.ObstacleHitboxInset = 0.5
+	obstacle = NewObstacle(CactusLarge, 80.0, groundLevel, config)
+	sprite := obstacle.GetSpriteBounds()
+	bounds := obstacle.GetBounds()
+
+	if sprite.Width != 5.0 || sprite.Height != 5.0 {
+		t.Errorf("Sprite extents should be unchanged by the inset, got %s", sprite)
+	}
+	if bounds.X != sprite.X+0.5 || bounds.Y != sprite.Y+0.5 {
+		t.Errorf("Expected hitbox origin inset by 0.5, got %s", bounds)
+	}
+	if bounds.Width != 4.0 || bounds.Height != 4.0 {
+		t.Errorf("Expected 4x4 hitbox inside 5x5 sprite, got %s", bounds)
+	}
+	if !sprite.Contains(bounds) {
+		t.Errorf("Expected hitbox %s inside sprite %s", bounds, sprite)
+	}
+
+	// The inset never collapses the hitbox past the sprite center
+	obstacle.SetHitboxInset(10)
+	bounds = obstacle.GetBounds()
+	if bounds.Width < 0 || bounds.Height < 0 {
+		t.Errorf("Expected non-negative hitbox size, got %s", bounds)
+	}
+
+	obstacle.SetHitboxInset(-1)
+	if obstacle.GetHitboxInset() != 0 {
+		t.Errorf("Expected negative inset clamped to 0, got %f", obstacle.GetHitboxInset())
+	}
+}
+
+func TestObstacleSpriteScale(t *testing.T) {
+	config := engine.NewDefaultConfig()
+	groundLevel := 30.0
+
+	unscaled := NewObstacle(CactusMedium, 80.0, groundLevel, config).GetBounds()
+	config.SpriteScale = 2
+	scaled := NewObstacle(CactusMedium, 80.0, groundLevel, config).GetBounds()
+
+	if scaled.Width != unscaled.Width*2 || scaled.Height != unscaled.Height*2 {
+		t.Errorf("Expected bounds doubled from %s, got %s", unscaled, scaled)
+	}
+	// Cacti still sit on the ground
+	if scaled.Y+scaled.Height != groundLevel {
+		t.Errorf("Expected scaled cactus bottom at %f, got %f", groundLevel, scaled.Y+scaled.Height)
+	}
+
+	// Birds fly proportionally higher
+	bird := NewObstacle(BirdHigh, 80.0, groundLevel, config).GetBounds()
+	if bird.Y != groundLevel-10.0 {
+		t.Errorf("Expected scaled high bird at %f, got %f", groundLevel-10.0, bird.Y)
+	}
+}
+
 func TestObstacleGetASCIIArt(t *testing.T) {
 	config := engine.NewDefaultConfig()
 	groundLevel := 15.0
@@ -229,6 +326,23 @@ func TestObstacleActiveMethods(t *testing.T) {
 	}
 }
 
+func TestObstacleScoredMethods(t *testing.T) {
+	config := engine.NewDefaultConfig()
+	obstacle := NewObstacle(CactusSmall, 80.0, 15.0, config)
+
+	if obstacle.IsScored() {
+		t.Error("Expected new obstacle not to be scored")
+	}
+
+	obstacle.MarkScored()
+	if !obstacle.IsScored() {
+		t.Error("Expected obstacle to be scored after MarkScored()")
+	}
+	if !obstacle.IsActive() {
+		t.Error("Expected MarkScored() not to change active state")
+	}
+}
+
 func TestObstacleGetters(t *testing.T) {
 	config := engine.NewDefaultConfig()
 	groundLevel := 15.0
@@ -287,6 +401,77 @@ func TestObstacleIsOffScreen(t *testing.T) {
 	}
 }
 
+func TestObstacleTimeToReach(t *testing.T) {
+	config := engine.NewDefaultConfig()
+	obstacle := NewObstacle(CactusSmall, 30.0, 15.0, config)
+	obstacle.SetSpeed(10.0)
+
+	if got := obstacle.TimeToReach(20.0); got != time.Second {
+		t.Errorf("Expected 1s to cover 10 units at speed 10, got %v", got)
+	}
+	if got := obstacle.TimeToReach(35.0); got != 0 {
+		t.Errorf("Expected zero time once the obstacle is past x, got %v", got)
+	}
+
+	obstacle.SetSpeed(0)
+	if got := obstacle.TimeToReach(20.0); got < time.Hour {
+		t.Errorf("Expected a stationary obstacle to never arrive, got %v", got)
+	}
+}
+
+func TestObstacleBirdAnimationUsesClock(t *testing.T) {
+	config := engine.NewDefaultConfig()
+	bird := NewObstacle(BirdMid, 60.0, 15.0, config)
+	fake := clock.NewFakeClock(time.Now())
+	bird.SetClock(fake)
+
+	// Without time passing the wings stay put
+	bird.Update(0.01)
+	if bird.AnimFrame != 0 {
+		t.Errorf("Expected frame 0 before any time passes, got %d", bird.AnimFrame)
+	}
+
+	// Each animation interval flaps to the other frame
+	fake.Advance(bird.animSpeed)
+	bird.Update(0.01)
+	if bird.AnimFrame != 1 {
+		t.Errorf("Expected frame 1 after one interval, got %d", bird.AnimFrame)
+	}
+	fake.Advance(bird.animSpeed)
+	bird.Update(0.01)
+	if bird.AnimFrame != 0 {
+		t.Errorf("Expected frame 0 after two intervals, got %d", bird.AnimFrame)
+	}
+}
+
+func TestObstacleResetMatchesNewObstacle(t *testing.T) {
+	config := engine.NewDefaultConfig()
+	config.SpriteScale = 2
+	fake := clock.NewFakeClock(time.Now())
+
+	// Run a bird for a while so every field drifts from its initial value
+	used := NewObstacle(BirdHigh, 80, 15, config)
+	used.SetClock(fake)
+	for i := 0; i < 3; i++ {
+		fake.Advance(250 * time.Millisecond)
+		used.Update(0.1)
+	}
+	used.SetSpeed(42)
+	used.SetHitboxInset(3)
+	used.MarkScored()
+	used.Deactivate()
+
+	fake.Advance(time.Second)
+	used.Reset(CactusLarge, 90, 15, config)
+
+	fresh := NewObstacle(CactusLarge, 90, 15, config)
+	fresh.SetClock(fake)
+
+	if !reflect.DeepEqual(*used, *fresh) {
+		t.Errorf("Expected reset obstacle to match a new one\n got: %+v\nwant: %+v", *used, *fresh)
+	}
+}
+
 func TestObstacleTypeString(t *testing.T) {
 	tests := []struct {
 		obstType ObstacleType
@@ -308,6 +493,144 @@ func TestObstacleTypeString(t *testing.T) {
 	}
 }
 
+func TestParseObstacleType(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected ObstacleType
+	}{
+		{"cactus-small", CactusSmall},
+		{"cactus-large", CactusLarge},
+		{"bird-mid", BirdMid},
+		{"BirdHigh", BirdHigh},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			obstType, err := ParseObstacleType(tt.name)
+			if err != nil || obstType != tt.expected {
+				t.Errorf("Expected %v, got %v (%v)", tt.expected, obstType, err)
+			}
+		})
+	}
+
+	if _, err := ParseObstacleType("pterodactyl"); err == nil {
+		t.Error("Expected an error for an unknown obstacle type")
+	}
+}
+
+func TestAllObstacleTypes(t *testing.T) {
+	tests := []struct {
+		obstType ObstacleType
+		name     string
+		w, h     float64
+		jumpable bool
+		duckable bool
+	}{
+		{CactusSmall, "Small cactus", 3, 3, true, false},
+		{CactusMedium, "Medium cactus", 3, 4, true, false},
+		{CactusLarge, "Large cactus", 5, 5, true, false},
+		{BirdLow, "Low bird", 4, 2, true, false},
+		{BirdMid, "Mid bird", 4, 2, true, false},
+		{BirdHigh, "High bird", 4, 2, true, false},
+	}
+
+	infos := AllObstacleTypes()
+	if len(infos) != len(tests) {
+		t.Fatalf("Expected %d obstacle types, got %d", len(tests), len(infos))
+	}
+
+	for i, tt := range tests {
+		t.Run(tt.obstType.String(), func(t *testing.T) {
+			info := infos[i]
+			if info.Type != tt.obstType || info.Name != tt.name {
+				t.Errorf("Expected %v %q, got %v %q", tt.obstType, tt.name, info.Type, info.Name)
+			}
+			if info.W != tt.w || info.H != tt.h {
+				t.Errorf("Expected %vx%v, got %vx%v", tt.w, tt.h, info.W, info.H)
+			}
+			if info.Jumpable != tt.jumpable || info.Duckable != tt.duckable {
+				t.Errorf("Expected jumpable=%v duckable=%v, got %v %v", tt.jumpable, tt.duckable, info.Jumpable, info.Duckable)
+			}
+
+			// The listing matches what NewObstacle builds
+			obstacle := NewObstacle(tt.obstType, 0, 20, engine.NewDefaultConfig())
+			if obstacle.Width != info.W || obstacle.Height != info.H {
+				t.Errorf("Expected NewObstacle to be %vx%v, got %vx%v", info.W, info.H, obstacle.Width, obstacle.Height)
+			}
+		})
+	}
+}
+
+func TestIsJumpableAndDuckable(t *testing.T) {
+	config := engine.NewDefaultConfig()
+	tests := []struct {
+		obstType ObstacleType
+		jumpable bool
+		duckable bool
+	}{
+		// Every default obstacle reaches the dinosaur's head or lower, so all
+		// of them are jumped and none passes overhead
+		{CactusSmall, true, false},
+		{CactusMedium, true, false},
+		{CactusLarge, true, false},
+		{BirdLow, true, false},
+		{BirdMid, true, false},
+		{BirdHigh, true, false},
+		{ObstacleType(999), false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.obstType.String(), func(t *testing.T) {
+			if got := IsJumpable(tt.obstType, config); got != tt.jumpable {
+				t.Errorf("Expected IsJumpable=%v, got %v", tt.jumpable, got)
+			}
+			if got := IsDuckable(tt.obstType, config); got != tt.duckable {
+				t.Errorf("Expected IsDuckable=%v, got %v", tt.duckable, got)
+			}
+		})
+	}
+
+	// A weak jump can't clear the large cactus
+	weak := engine.NewDefaultConfig()
+	weak.JumpVelocity /= 2
+	if IsJumpable(CactusLarge, weak) {
+		t.Error("Expected a weak jump not to clear the large cactus")
+	}
+
+	// Without gravity nothing is jumped
+	weak.Gravity = 0
+	if IsJumpable(CactusSmall, weak) {
+		t.Error("Expected nothing to be jumpable without gravity")
+	}
+
+	// A bird flying above the dinosaur's head can be run under, at any scale
+	defer ResetObstacleDimensions()
+	SetObstacleDimension(BirdHigh, ObstacleDimension{W: 4, H: 2, YOffset: 7})
+	scaled := engine.NewDefaultConfig()
+	scaled.SpriteScale = 2
+	if !IsDuckable(BirdHigh, config) || !IsDuckable(BirdHigh, scaled) {
+		t.Error("Expected a raised bird to be duckable")
+	}
+}
+
+func TestAllObstacleTypesFollowsOverrides(t *testing.T) {
+	defer ResetObstacleDimensions()
+
+	// A bird flying above the dinosaur's head can be run under
+	SetObstacleDimension(BirdHigh, ObstacleDimension{W: 4, H: 2, YOffset: 7})
+	for _, info := range AllObstacleTypes() {
+		if info.Type != BirdHigh {
+			continue
+		}
+		if info.YOffset != 7 {
+			t.Errorf("Expected the overridden offset 7, got %v", info.YOffset)
+		}
+		if !info.Duckable || info.Jumpable {
+			t.Errorf("Expected a raised bird to be duckable but not jumpable, got duckable=%v jumpable=%v", info.Duckable, info.Jumpable)
+		}
+	}
+}
+
 func TestObstacleMovementLifecycle(t *testing.T) {
 	config := engine.NewDefaultConfig()
 	groundLevel := 15.0
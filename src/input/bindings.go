@@ -0,0 +1,33 @@
+package input
+
+import "strings"
+
+// Binding pairs an action with the keys that trigger it
+type Binding struct {
+	Keys   []Key
+	Action string
+}
+
+// DefaultBindings returns the game's key bindings in the order help lists them
+func DefaultBindings() []Binding {
+	return []Binding{
+		{Keys: []Key{KeySpace, KeyUp}, Action: "Jump / start"},
+		{Keys: []Key{KeyUp, KeyDown}, Action: "Choose a menu item"},
+		{Keys: []Key{KeyEnter}, Action: "Select / run summary"},
+		{Keys: []Key{KeyR}, Action: "Restart after game over"},
+		{Keys: []Key{KeyEscape}, Action: "Back to the menu"},
+		{Keys: []Key{KeyB}, Action: "Show collision boxes"},
+		{Keys: []Key{KeyH}, Action: "Help"},
+		{Keys: []Key{KeyCtrlZ}, Action: "Suspend"},
+		{Keys: []Key{KeyQ, KeyCtrlC}, Action: "Quit"},
+	}
+}
+
+// KeyNames returns the binding's keys for display, such as "Space/Up"
+func (b Binding) KeyNames() string {
+	names := make([]string, len(b.Keys))
+	for i, key := range b.Keys {
+		names[i] = key.String()
+	}
+	return strings.Join(names, "/")
+}
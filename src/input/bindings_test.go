@@ -0,0 +1,37 @@
+package input
+
+import "testing"
+
+func TestBindingKeyNames(t *testing.T) {
+	tests := []struct {
+		binding  Binding
+		expected string
+	}{
+		{Binding{Keys: []Key{KeySpace, KeyUp}}, "Space/Up"},
+		{Binding{Keys: []Key{KeyCtrlZ}}, "Ctrl+Z"},
+		{Binding{}, ""},
+	}
+
+	for _, tt := range tests {
+		if names := tt.binding.KeyNames(); names != tt.expected {
+			t.Errorf("Expected %q, got %q", tt.expected, names)
+		}
+	}
+}
+
+func TestDefaultBindingsCoverHelp(t *testing.T) {
+	found := false
+	for _, binding := range DefaultBindings() {
+		if binding.Action == "" || len(binding.Keys) == 0 {
+			t.Errorf("Expected every binding to have keys and an action, got %+v", binding)
+		}
+		for _, key := range binding.Keys {
+			if key == KeyH {
+				found = true
+			}
+		}
+	}
+	if !found {
+		t.Error("Expected H to be bound to help")
+	}
+}
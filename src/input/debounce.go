@@ -0,0 +1,44 @@
+package input
+
+import "time"
+
+// Debouncer collapses repeated events that arrive within a time window into one
+type Debouncer struct {
+	window   time.Duration
+	last     time.Time
+	accepted bool
+}
+
+// NewDebouncer creates a debouncer with the given window (0 accepts every event)
+func NewDebouncer(window time.Duration) *Debouncer {
+	return &Debouncer{
+		window: window,
+	}
+}
+
+// Allow reports whether an event occurring at the given time should be acted on.
+// Events within the window of the last accepted event are ignored.
+func (d *Debouncer) Allow(eventTime time.Time) bool {
+	if d.accepted && eventTime.Sub(d.last) < d.window {
+		return false
+	}
+	d.last = eventTime
+	d.accepted = true
+	return true
+}
+
+// SetWindow changes the debounce window
+func (d *Debouncer) SetWindow(window time.Duration) {
+	d.window = window
+}
+
+// GetWindow returns the debounce window
+func (d *Debouncer) GetWindow() time.Duration {
+	return d.window
+}
+
+// Reset forgets the last accepted event
+func (d *Debouncer) Reset() {
+	d.accepted = false
+	d.last = time.Time{}
+}
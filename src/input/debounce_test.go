@@ -0,0 +1,65 @@
+package input
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDebouncerWithinWindow(t *testing.T) {
+	debouncer := NewDebouncer(50 * time.Millisecond)
+	start := time.Now()
+
+	if !debouncer.Allow(start) {
+		t.Error("First event should be allowed")
+	}
+	if debouncer.Allow(start.Add(30 * time.Millisecond)) {
+		t.Error("Second event within the window should be ignored")
+	}
+}
+
+func TestDebouncerOutsideWindow(t *testing.T) {
+	debouncer := NewDebouncer(50 * time.Millisecond)
+	start := time.Now()
+
+	if !debouncer.Allow(start) {
+		t.Error("First event should be allowed")
+	}
+	if !debouncer.Allow(start.Add(60 * time.Millisecond)) {
+		t.Error("Second event outside the window should be allowed")
+	}
+}
+
+func TestDebouncerWindowMeasuredFromAcceptedEvent(t *testing.T) {
+	debouncer := NewDebouncer(50 * time.Millisecond)
+	start := time.Now()
+
+	debouncer.Allow(start)
+	debouncer.Allow(start.Add(40 * time.Millisecond)) // Ignored
+
+	// Ignored events do not extend the window
+	if !debouncer.Allow(start.Add(55 * time.Millisecond)) {
+		t.Error("Event after the window of the accepted event should be allowed")
+	}
+}
+
+func TestDebouncerZeroWindow(t *testing.T) {
+	debouncer := NewDebouncer(0)
+	now := time.Now()
+
+	for i := 0; i < 3; i++ {
+		if !debouncer.Allow(now) {
+			t.Errorf("Event %d should be allowed with a zero window", i)
+		}
+	}
+}
+
+func TestDebouncerReset(t *testing.T) {
+	debouncer := NewDebouncer(50 * time.Millisecond)
+	now := time.Now()
+
+	debouncer.Allow(now)
+	debouncer.Reset()
+	if !debouncer.Allow(now.Add(time.Millisecond)) {
+		t.Error("Event after Reset should be allowed")
+	}
+}
@@ -11,6 +11,7 @@
 //   - Channel-based communication for concurrent processing
 //   - Proper terminal state management and restoration
 //   - Input event timestamping for precise timing
+//   - Held-key pacing with KeyRepeat (menu auto-repeat, single jumps)
 //
 // Basic Usage:
 //
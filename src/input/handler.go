@@ -1,6 +1,8 @@
 package input
 
 import (
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/nsf/termbox-go"
@@ -10,6 +12,13 @@ import (
 type InputHandler struct {
 	inputChan chan InputEvent
 	done      chan bool
+	stopOnce  sync.Once
+
+	// Run when the input goroutine panics, before the panic is passed on
+	panicCleanup func()
+
+	// Number of events dropped because the channel was full
+	droppedEvents atomic.Int64
 }
 
 // NewInputHandler creates a new termbox-based InputHandler instance
@@ -23,16 +32,38 @@ func NewInputHandler() *InputHandler {
 // Start begins the input processing loop in a separate goroutine
 func (h *InputHandler) Start() error {
 	// Start input processing goroutine
-	go h.processInput()
+	go h.guard(h.processInput)
 	return nil
 }
 
-// Stop stops the input processing
+// Stop stops the input processing. Stopping again does nothing.
 func (h *InputHandler) Stop() error {
-	close(h.done)
+	h.stopOnce.Do(func() {
+		close(h.done)
+	})
 	return nil
 }
 
+// SetPanicCleanup sets a function to run if the input goroutine panics, before
+// the panic crashes the program, so the terminal can be restored first
+func (h *InputHandler) SetPanicCleanup(cleanup func()) {
+	h.panicCleanup = cleanup
+}
+
+// guard runs work, calling the panic cleanup before passing on a panic so the
+// crash report lands on a usable terminal
+func (h *InputHandler) guard(work func()) {
+	defer func() {
+		if r := recover(); r != nil {
+			if h.panicCleanup != nil {
+				h.panicCleanup()
+			}
+			panic(r)
+		}
+	}()
+	work()
+}
+
 // GetInputChannel returns the channel for receiving input events
 func (h *InputHandler) GetInputChannel() <-chan InputEvent {
 	return h.inputChan
@@ -48,20 +79,13 @@ func (h *InputHandler) processInput() {
 			// Poll for events with timeout
 			switch ev := termbox.PollEvent(); ev.Type {
 			case termbox.EventKey:
-				key := h.parseTermboxKey(ev)
-				if key != KeyUnknown {
-					event := InputEvent{
-						Key:  key,
-						Time: time.Now(),
-					}
-
-					// Non-blocking send to channel
-					select {
-					case h.inputChan <- event:
-					default:
-						// Channel full, drop event
-					}
+				// Unmapped keys are delivered as KeyUnknown so "press any key" prompts work
+				event := InputEvent{
+					Key:  h.parseTermboxKey(ev),
+					Time: time.Now(),
 				}
+
+				h.sendEvent(event)
 			case termbox.EventResize:
 				// Handle resize events if needed
 				continue
@@ -70,6 +94,28 @@ func (h *InputHandler) processInput() {
 	}
 }
 
+// sendEvent delivers an event without blocking, counting it as dropped if the channel is full
+func (h *InputHandler) sendEvent(event InputEvent) {
+	select {
+	case h.inputChan <- event:
+	default:
+		// Channel full, drop event
+		h.droppedEvents.Add(1)
+	}
+}
+
+// InjectEvent delivers an event as if it had been typed, without a terminal, so
+// tests can drive the same channel the game loop reads. Like typed keys it is
+// dropped if the channel is full.
+func (h *InputHandler) InjectEvent(event InputEvent) {
+	h.sendEvent(event)
+}
+
+// DroppedEventCount returns how many input events were dropped because the channel was full
+func (h *InputHandler) DroppedEventCount() int64 {
+	return h.droppedEvents.Load()
+}
+
 // parseTermboxKey converts termbox key events to our Key type
 func (h *InputHandler) parseTermboxKey(ev termbox.Event) Key {
 	switch {
@@ -77,8 +123,16 @@ func (h *InputHandler) parseTermboxKey(ev termbox.Event) Key {
 		return KeySpace
 	case ev.Key == termbox.KeyArrowUp:
 		return KeyUp
+	case ev.Key == termbox.KeyArrowDown:
+		return KeyDown
+	case ev.Key == termbox.KeyEnter:
+		return KeyEnter
 	case ev.Key == termbox.KeyCtrlC:
 		return KeyCtrlC
+	case ev.Key == termbox.KeyCtrlZ:
+		return KeyCtrlZ
+	case ev.Key == termbox.KeyEsc:
+		return KeyEscape
 	case ev.Ch != 0:
 		// Handle character keys
 		switch ev.Ch {
@@ -86,6 +140,20 @@ func (h *InputHandler) parseTermboxKey(ev termbox.Event) Key {
 			return KeyQ
 		case 'r', 'R':
 			return KeyR
+		case 'y', 'Y':
+			return KeyY
+		case 'n', 'N':
+			return KeyN
+		case 'b', 'B':
+			return KeyB
+		case 'f', 'F':
+			return KeyF
+		case 'p', 'P':
+			return KeyP
+		case 's', 'S':
+			return KeyS
+		case 'h', 'H':
+			return KeyH
 		default:
 			return KeyUnknown
 		}
@@ -3,6 +3,8 @@ package input
 import (
 	"testing"
 	"time"
+
+	"github.com/nsf/termbox-go"
 )
 
 func TestNewInputHandler(t *testing.T) {
@@ -62,6 +64,127 @@ func TestInputHandlerChannelBuffering(t *testing.T) {
 	}
 }
 
+func TestInputHandlerDroppedEventCount(t *testing.T) {
+	handler := NewInputHandler()
+
+	if handler.DroppedEventCount() != 0 {
+		t.Errorf("Expected no dropped events initially, got %d", handler.DroppedEventCount())
+	}
+
+	// Fill the buffered channel, then send more
+	capacity := cap(handler.inputChan)
+	for i := 0; i < capacity+5; i++ {
+		handler.sendEvent(InputEvent{Key: KeySpace, Time: time.Now()})
+	}
+
+	if handler.DroppedEventCount() != 5 {
+		t.Errorf("Expected 5 dropped events, got %d", handler.DroppedEventCount())
+	}
+	if len(handler.inputChan) != capacity {
+		t.Errorf("Expected channel to be full with %d events, got %d", capacity, len(handler.inputChan))
+	}
+}
+
+func TestInputHandlerInjectEvent(t *testing.T) {
+	handler := NewInputHandler()
+	now := time.Now()
+
+	keys := []Key{KeySpace, KeyUp, KeyEscape}
+	for _, key := range keys {
+		handler.InjectEvent(InputEvent{Key: key, Time: now})
+	}
+
+	for i, key := range keys {
+		select {
+		case event := <-handler.GetInputChannel():
+			if event.Key != key || !event.Time.Equal(now) {
+				t.Errorf("Event %d: expected %v at %v, got %v at %v", i, key, now, event.Key, event.Time)
+			}
+		default:
+			t.Fatalf("Expected injected event %d on the input channel", i)
+		}
+	}
+
+	// Injected events are dropped like typed ones when the channel is full
+	for i := 0; i < cap(handler.inputChan)+2; i++ {
+		handler.InjectEvent(InputEvent{Key: KeySpace, Time: now})
+	}
+	if handler.DroppedEventCount() != 2 {
+		t.Errorf("Expected 2 dropped events, got %d", handler.DroppedEventCount())
+	}
+}
+
+func TestParseTermboxKey(t *testing.T) {
+	handler := NewInputHandler()
+
+	tests := []struct {
+		name     string
+		event    termbox.Event
+		expected Key
+	}{
+		{"space", termbox.Event{Key: termbox.KeySpace}, KeySpace},
+		{"arrow up", termbox.Event{Key: termbox.KeyArrowUp}, KeyUp},
+		{"arrow down", termbox.Event{Key: termbox.KeyArrowDown}, KeyDown},
+		{"enter", termbox.Event{Key: termbox.KeyEnter}, KeyEnter},
+		{"ctrl+c", termbox.Event{Key: termbox.KeyCtrlC}, KeyCtrlC},
+		{"ctrl+z", termbox.Event{Key: termbox.KeyCtrlZ}, KeyCtrlZ},
+		{"escape", termbox.Event{Key: termbox.KeyEsc}, KeyEscape},
+		{"q", termbox.Event{Ch: 'q'}, KeyQ},
+		{"R", termbox.Event{Ch: 'R'}, KeyR},
+		{"y", termbox.Event{Ch: 'y'}, KeyY},
+		{"N", termbox.Event{Ch: 'N'}, KeyN},
+		{"b", termbox.Event{Ch: 'b'}, KeyB},
+		{"F", termbox.Event{Ch: 'F'}, KeyF},
+		{"p", termbox.Event{Ch: 'p'}, KeyP},
+		{"s", termbox.Event{Ch: 's'}, KeyS},
+		{"h", termbox.Event{Ch: 'h'}, KeyH},
+		{"unmapped character", termbox.Event{Ch: 'z'}, KeyUnknown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if key := handler.parseTermboxKey(tt.event); key != tt.expected {
+				t.Errorf("Expected %v, got %v", tt.expected, key)
+			}
+		})
+	}
+}
+
+func TestDefaultBindingsMatchParsedKeys(t *testing.T) {
+	handler := NewInputHandler()
+
+	// Every key the terminal can produce, from the special keys and printable characters
+	parsed := map[Key]bool{}
+	specials := []termbox.Key{termbox.KeySpace, termbox.KeyArrowUp, termbox.KeyArrowDown,
+		termbox.KeyArrowLeft, termbox.KeyArrowRight, termbox.KeyEnter, termbox.KeyEsc,
+		termbox.KeyTab, termbox.KeyBackspace, termbox.KeyCtrlC, termbox.KeyCtrlZ}
+	for _, key := range specials {
+		parsed[handler.parseTermboxKey(termbox.Event{Key: key})] = true
+	}
+	for ch := rune('!'); ch <= '~'; ch++ {
+		parsed[handler.parseTermboxKey(termbox.Event{Ch: ch})] = true
+	}
+	delete(parsed, KeyUnknown)
+
+	bound := map[Key]bool{}
+	for _, binding := range DefaultBindings() {
+		for _, key := range binding.Keys {
+			bound[key] = true
+			if !parsed[key] {
+				t.Errorf("Expected %v, bound to %q, to be a key the terminal produces", key, binding.Action)
+			}
+		}
+	}
+
+	// Keys help leaves out: the quit prompt's answers and the debug-only controls
+	unlisted := map[Key]bool{KeyY: true, KeyN: true, KeyF: true, KeyP: true, KeyS: true}
+	for key := range parsed {
+		if !bound[key] && !unlisted[key] {
+			t.Errorf("Expected %v to be listed in the default bindings", key)
+		}
+	}
+}
+
 func TestInputHandlerStop(t *testing.T) {
 	handler := NewInputHandler()
 
@@ -72,6 +195,55 @@ func TestInputHandlerStop(t *testing.T) {
 	}
 }
 
+func TestInputHandlerStopTwice(t *testing.T) {
+	handler := NewInputHandler()
+	handler.Stop()
+
+	// A crash cleanup may stop the handler before the deferred Stop runs
+	if err := handler.Stop(); err != nil {
+		t.Errorf("Second Stop() returned error: %v", err)
+	}
+}
+
+func TestInputHandlerPanicCleanup(t *testing.T) {
+	handler := NewInputHandler()
+	cleaned := make(chan bool, 1)
+	handler.SetPanicCleanup(func() {
+		cleaned <- true
+	})
+
+	// Simulate the input goroutine crashing, catching the panic guard passes on
+	recovered := make(chan any, 1)
+	go func() {
+		defer func() {
+			recovered <- recover()
+		}()
+		handler.guard(func() {
+			panic("worker crashed")
+		})
+	}()
+
+	select {
+	case r := <-recovered:
+		if r != "worker crashed" {
+			t.Errorf("Expected the worker's panic to be passed on, got %v", r)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Timeout waiting for the worker to panic")
+	}
+	select {
+	case <-cleaned:
+	default:
+		t.Error("Expected the panic cleanup to run before the panic was passed on")
+	}
+
+	// Work that returns normally doesn't clean up
+	handler.guard(func() {})
+	if len(cleaned) != 0 {
+		t.Error("Expected no cleanup without a panic")
+	}
+}
+
 // TestInputEventTiming verifies that InputEvent captures timing correctly
 func TestInputEventTiming(t *testing.T) {
 	before := time.Now()
@@ -0,0 +1,84 @@
+package input
+
+import "time"
+
+// DefaultReleaseGap is how long a key can go without an event before it counts
+// as released. Terminals report no key releases, only the auto-repeat events
+// sent while a key is held, which arrive well within this gap. The OS waits
+// longer than this before a held key first repeats, so that first repeat counts
+// as a fresh press, which keeps quick double-taps from being taken for a hold.
+const DefaultReleaseGap = 100 * time.Millisecond
+
+// KeyRepeat paces the events from a held key. A fresh press is always acted on;
+// while the key stays held, repeats are acted on once the initial delay has
+// passed and then once per interval.
+type KeyRepeat struct {
+	delay      time.Duration
+	interval   time.Duration
+	releaseGap time.Duration
+	repeats    bool
+
+	key        Key
+	pressStart time.Time // When the current hold began
+	lastEvent  time.Time // Last event seen for the held key
+	lastFire   time.Time // Last event that was acted on
+	active     bool
+}
+
+// NewKeyRepeat creates a repeater that acts on a held key after delay, then every interval
+func NewKeyRepeat(delay, interval time.Duration) *KeyRepeat {
+	return &KeyRepeat{
+		delay:      delay,
+		interval:   interval,
+		releaseGap: DefaultReleaseGap,
+		repeats:    true,
+	}
+}
+
+// NewNoRepeat creates a repeater that acts on each press once, however long the key is held
+func NewNoRepeat() *KeyRepeat {
+	return &KeyRepeat{
+		releaseGap: DefaultReleaseGap,
+	}
+}
+
+// Allow reports whether an event should be acted on
+func (r *KeyRepeat) Allow(event InputEvent) bool {
+	if !r.IsHeld(event.Key, event.Time) {
+		// A fresh press
+		r.key = event.Key
+		r.pressStart = event.Time
+		r.lastEvent = event.Time
+		r.lastFire = event.Time
+		r.active = true
+		return true
+	}
+
+	r.lastEvent = event.Time
+	if !r.repeats || event.Time.Sub(r.pressStart) < r.delay || event.Time.Sub(r.lastFire) < r.interval {
+		return false
+	}
+	r.lastFire = event.Time
+	return true
+}
+
+// IsHeld reports whether the key is still held at the given time, judged by how
+// recently its last event arrived
+func (r *KeyRepeat) IsHeld(key Key, now time.Time) bool {
+	return r.active && key == r.key && now.Sub(r.lastEvent) <= r.releaseGap
+}
+
+// SetReleaseGap sets how long a key can go without an event before it counts as released
+func (r *KeyRepeat) SetReleaseGap(gap time.Duration) {
+	r.releaseGap = gap
+}
+
+// GetReleaseGap returns how long a key can go without an event before it counts as released
+func (r *KeyRepeat) GetReleaseGap() time.Duration {
+	return r.releaseGap
+}
+
+// Reset forgets the held key
+func (r *KeyRepeat) Reset() {
+	r.active = false
+}
@@ -0,0 +1,118 @@
+package input
+
+import (
+	"testing"
+	"time"
+)
+
+// holdKey returns the events a terminal sends while a key is held: one every
+// step, starting at start, for the given duration
+func holdKey(key Key, start time.Time, duration, step time.Duration) []InputEvent {
+	var events []InputEvent
+	for t := time.Duration(0); t <= duration; t += step {
+		events = append(events, InputEvent{Key: key, Time: start.Add(t)})
+	}
+	return events
+}
+
+func TestKeyRepeatMenuTiming(t *testing.T) {
+	repeat := NewKeyRepeat(300*time.Millisecond, 100*time.Millisecond)
+	start := time.Now()
+
+	// Hold Down for a second, with the terminal repeating every 30ms
+	var fired []time.Duration
+	for _, event := range holdKey(KeyDown, start, time.Second, 30*time.Millisecond) {
+		if repeat.Allow(event) {
+			fired = append(fired, event.Time.Sub(start))
+		}
+	}
+
+	expected := []time.Duration{0, 300 * time.Millisecond, 420 * time.Millisecond, 540 * time.Millisecond,
+		660 * time.Millisecond, 780 * time.Millisecond, 900 * time.Millisecond}
+	if len(fired) != len(expected) {
+		t.Fatalf("Expected %d moves while held, got %d: %v", len(expected), len(fired), fired)
+	}
+	for i := range expected {
+		if fired[i] != expected[i] {
+			t.Errorf("Move %d: expected at %v, got %v", i, expected[i], fired[i])
+		}
+	}
+}
+
+func TestKeyRepeatSeparatePresses(t *testing.T) {
+	repeat := NewKeyRepeat(300*time.Millisecond, 100*time.Millisecond)
+	start := time.Now()
+
+	// Taps further apart than the release gap are separate presses
+	for i := 0; i < 3; i++ {
+		event := InputEvent{Key: KeyDown, Time: start.Add(time.Duration(i) * 150 * time.Millisecond)}
+		if !repeat.Allow(event) {
+			t.Errorf("Expected tap %d to act", i)
+		}
+	}
+
+	// Switching keys is a fresh press even while the first is repeating
+	if !repeat.Allow(InputEvent{Key: KeyUp, Time: start.Add(320 * time.Millisecond)}) {
+		t.Error("Expected a different key to act immediately")
+	}
+	if repeat.IsHeld(KeyDown, start.Add(320*time.Millisecond)) {
+		t.Error("Expected Down to no longer be held")
+	}
+	if !repeat.IsHeld(KeyUp, start.Add(400*time.Millisecond)) {
+		t.Error("Expected Up to be held within the release gap")
+	}
+	if repeat.IsHeld(KeyUp, start.Add(500*time.Millisecond)) {
+		t.Error("Expected Up to count as released after the release gap")
+	}
+}
+
+func TestKeyRepeatQuickDoubleTap(t *testing.T) {
+	start := time.Now()
+	tests := []struct {
+		name   string
+		repeat *KeyRepeat
+		key    Key
+	}{
+		{"menu", NewKeyRepeat(300*time.Millisecond, 100*time.Millisecond), KeyDown},
+		{"jump", NewNoRepeat(), KeySpace},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Two taps 200ms apart are two presses, not a hold
+			for i := 0; i < 2; i++ {
+				event := InputEvent{Key: tt.key, Time: start.Add(time.Duration(i) * 200 * time.Millisecond)}
+				if !tt.repeat.Allow(event) {
+					t.Errorf("Expected tap %d to act", i)
+				}
+			}
+		})
+	}
+}
+
+func TestNoRepeatForJump(t *testing.T) {
+	repeat := NewNoRepeat()
+	start := time.Now()
+
+	// Holding jump acts once
+	count := 0
+	for _, event := range holdKey(KeySpace, start, 2*time.Second, 30*time.Millisecond) {
+		if repeat.Allow(event) {
+			count++
+		}
+	}
+	if count != 1 {
+		t.Errorf("Expected a held jump key to act once, acted %d times", count)
+	}
+
+	// Releasing and pressing again jumps again
+	if !repeat.Allow(InputEvent{Key: KeySpace, Time: start.Add(3 * time.Second)}) {
+		t.Error("Expected a new press after release to act")
+	}
+
+	// A reset forgets the held key
+	repeat.Reset()
+	if !repeat.Allow(InputEvent{Key: KeySpace, Time: start.Add(3*time.Second + 30*time.Millisecond)}) {
+		t.Error("Expected the first event after a reset to act")
+	}
+}
@@ -0,0 +1,100 @@
+package input
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ReplayEvent is a key pressed on a given frame of a recorded run, counting
+// from the frame the run started on
+type ReplayEvent struct {
+	Frame int
+	Key   Key
+}
+
+// ParseKey returns the key with the given name, as written by Key.String
+func ParseKey(name string) (Key, error) {
+	for k := KeySpace; k < KeyUnknown; k++ {
+		if strings.EqualFold(k.String(), name) {
+			return k, nil
+		}
+	}
+	return KeyUnknown, fmt.Errorf("unknown key %q", name)
+}
+
+// ReadReplay reads a recorded run: one "frame key" pair per line, such as
+// "120 Space". Blank lines and lines starting with # are ignored.
+func ReadReplay(r io.Reader) ([]ReplayEvent, error) {
+	var events []ReplayEvent
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		fields := strings.Fields(text)
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("line %d: expected \"frame key\", got %q", line, text)
+		}
+		frame, err := strconv.Atoi(fields[0])
+		if err != nil || frame < 0 {
+			return nil, fmt.Errorf("line %d: invalid frame %q", line, fields[0])
+		}
+		key, err := ParseKey(fields[1])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		events = append(events, ReplayEvent{Frame: frame, Key: key})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read replay: %w", err)
+	}
+	return events, nil
+}
+
+// WriteReplay writes events in the format ReadReplay reads
+func WriteReplay(w io.Writer, events []ReplayEvent) error {
+	for _, event := range events {
+		if _, err := fmt.Fprintf(w, "%d %s\n", event.Frame, event.Key); err != nil {
+			return fmt.Errorf("failed to write replay: %w", err)
+		}
+	}
+	return nil
+}
+
+// Replay feeds the key presses of a recorded run back frame by frame
+type Replay struct {
+	events []ReplayEvent
+	next   int
+}
+
+// NewReplay creates a replay of the given events. Keys pressed on the same
+// frame are played back in the order they were recorded.
+func NewReplay(events []ReplayEvent) *Replay {
+	sorted := append([]ReplayEvent(nil), events...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Frame < sorted[j].Frame
+	})
+	return &Replay{events: sorted}
+}
+
+// KeysAt returns the keys pressed up to and including the given frame that
+// haven't been played back yet
+func (r *Replay) KeysAt(frame int) []Key {
+	var keys []Key
+	for r.next < len(r.events) && r.events[r.next].Frame <= frame {
+		keys = append(keys, r.events[r.next].Key)
+		r.next++
+	}
+	return keys
+}
+
+// Done reports whether every recorded key has been played back
+func (r *Replay) Done() bool {
+	return r.next >= len(r.events)
+}
@@ -0,0 +1,77 @@
+package input
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseKey(t *testing.T) {
+	for k := KeySpace; k < KeyUnknown; k++ {
+		parsed, err := ParseKey(k.String())
+		if err != nil || parsed != k {
+			t.Errorf("Expected %q to parse as %v, got %v (%v)", k.String(), k, parsed, err)
+		}
+	}
+
+	if key, err := ParseKey("space"); err != nil || key != KeySpace {
+		t.Errorf("Expected key names to be case-insensitive, got %v (%v)", key, err)
+	}
+	if _, err := ParseKey("Tab"); err == nil {
+		t.Error("Expected an error for an unknown key name")
+	}
+}
+
+func TestReplayRoundTrip(t *testing.T) {
+	events := []ReplayEvent{{Frame: 0, Key: KeySpace}, {Frame: 45, Key: KeyUp}, {Frame: 90, Key: KeyB}}
+
+	var buf bytes.Buffer
+	if err := WriteReplay(&buf, events); err != nil {
+		t.Fatalf("Failed to write replay: %v", err)
+	}
+	read, err := ReadReplay(&buf)
+	if err != nil {
+		t.Fatalf("Failed to read replay: %v", err)
+	}
+	if !reflect.DeepEqual(read, events) {
+		t.Errorf("Expected %v, got %v", events, read)
+	}
+}
+
+func TestReadReplay(t *testing.T) {
+	events, err := ReadReplay(strings.NewReader("# recorded run\n\n10 Space\n  20 up  \n"))
+	if err != nil {
+		t.Fatalf("Failed to read replay: %v", err)
+	}
+	expected := []ReplayEvent{{Frame: 10, Key: KeySpace}, {Frame: 20, Key: KeyUp}}
+	if !reflect.DeepEqual(events, expected) {
+		t.Errorf("Expected %v, got %v", expected, events)
+	}
+
+	for _, bad := range []string{"10", "ten Space", "-1 Space", "10 Tab"} {
+		if _, err := ReadReplay(strings.NewReader(bad)); err == nil {
+			t.Errorf("Expected an error reading %q", bad)
+		}
+	}
+}
+
+func TestReplayKeysAt(t *testing.T) {
+	replay := NewReplay([]ReplayEvent{{Frame: 5, Key: KeyUp}, {Frame: 2, Key: KeySpace}, {Frame: 5, Key: KeyB}})
+
+	if keys := replay.KeysAt(1); len(keys) != 0 {
+		t.Errorf("Expected no keys before frame 2, got %v", keys)
+	}
+	if keys := replay.KeysAt(3); !reflect.DeepEqual(keys, []Key{KeySpace}) {
+		t.Errorf("Expected Space by frame 3, got %v", keys)
+	}
+	if replay.Done() {
+		t.Error("Expected keys left to play back")
+	}
+	if keys := replay.KeysAt(5); !reflect.DeepEqual(keys, []Key{KeyUp, KeyB}) {
+		t.Errorf("Expected Up then B on frame 5, got %v", keys)
+	}
+	if !replay.Done() {
+		t.Error("Expected every key played back")
+	}
+}
@@ -11,6 +11,17 @@ const (
 	KeyQ
 	KeyR
 	KeyCtrlC
+	KeyDown
+	KeyEnter
+	KeyEscape
+	KeyY
+	KeyN
+	KeyB
+	KeyF
+	KeyP
+	KeyS
+	KeyCtrlZ
+	KeyH
 	KeyUnknown
 )
 
@@ -33,6 +44,28 @@ func (k Key) String() string {
 		return "R"
 	case KeyCtrlC:
 		return "Ctrl+C"
+	case KeyDown:
+		return "Down"
+	case KeyEnter:
+		return "Enter"
+	case KeyEscape:
+		return "Esc"
+	case KeyY:
+		return "Y"
+	case KeyN:
+		return "N"
+	case KeyB:
+		return "B"
+	case KeyF:
+		return "F"
+	case KeyP:
+		return "P"
+	case KeyS:
+		return "S"
+	case KeyCtrlZ:
+		return "Ctrl+Z"
+	case KeyH:
+		return "H"
 	default:
 		return "Unknown"
 	}
@@ -15,6 +15,17 @@ func TestKeyString(t *testing.T) {
 		{KeyQ, "Q"},
 		{KeyR, "R"},
 		{KeyCtrlC, "Ctrl+C"},
+		{KeyDown, "Down"},
+		{KeyEnter, "Enter"},
+		{KeyEscape, "Esc"},
+		{KeyY, "Y"},
+		{KeyN, "N"},
+		{KeyB, "B"},
+		{KeyF, "F"},
+		{KeyP, "P"},
+		{KeyS, "S"},
+		{KeyCtrlZ, "Ctrl+Z"},
+		{KeyH, "H"},
 		{KeyUnknown, "Unknown"},
 	}
 
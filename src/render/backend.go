@@ -0,0 +1,212 @@
+package render
+
+import (
+	"github.com/nsf/termbox-go"
+)
+
+// Cell represents a single character cell on the screen
+type Cell struct {
+	Ch rune
+	Fg termbox.Attribute
+	Bg termbox.Attribute
+}
+
+// blankCell is the cell used for cleared screen positions
+var blankCell = Cell{Ch: ' ', Fg: termbox.ColorDefault, Bg: termbox.ColorDefault}
+
+// Backend is the low-level cell output used by the Renderer
+type Backend interface {
+	Init() error
+	SetCell(x, y int, ch rune, fg, bg termbox.Attribute)
+	Clear(fg, bg termbox.Attribute)
+	Flush() error
+	Size() (int, int)
+	SetCursor(x, y int)
+	HideCursor()
+	Close()
+}
+
+// termboxBackend writes cells straight to the terminal through termbox
+type termboxBackend struct{}
+
+// Init takes over the terminal for drawing and key input
+func (termboxBackend) Init() error {
+	if err := termbox.Init(); err != nil {
+		return err
+	}
+
+	// Set input mode for better key handling
+	termbox.SetInputMode(termbox.InputEsc)
+	return nil
+}
+
+// SetCell sets a cell in the termbox back buffer
+func (termboxBackend) SetCell(x, y int, ch rune, fg, bg termbox.Attribute) {
+	termbox.SetCell(x, y, ch, fg, bg)
+}
+
+// Clear clears the termbox back buffer
+func (termboxBackend) Clear(fg, bg termbox.Attribute) {
+	termbox.Clear(fg, bg)
+}
+
+// Flush syncs the termbox back buffer with the terminal
+func (termboxBackend) Flush() error {
+	return termbox.Flush()
+}
+
+// Size returns the terminal size
+func (termboxBackend) Size() (int, int) {
+	return termbox.Size()
+}
+
+// SetCursor shows the terminal cursor at the given position
+func (termboxBackend) SetCursor(x, y int) {
+	termbox.SetCursor(x, y)
+}
+
+// HideCursor hides the terminal cursor
+func (termboxBackend) HideCursor() {
+	termbox.HideCursor()
+}
+
+// Close restores the terminal
+func (termboxBackend) Close() {
+	termbox.Close()
+}
+
+// BufferBackend is an in-memory Backend used for headless rendering and tests
+type BufferBackend struct {
+	width  int
+	height int
+	cells  []Cell
+
+	setCellCalls int
+	outOfBounds  int
+	flushCalls   int
+	closed       bool
+
+	// Cursor state, visible like a terminal's until hidden
+	cursorX       int
+	cursorY       int
+	cursorVisible bool
+}
+
+// NewBufferBackend creates a new in-memory backend of the given size
+func NewBufferBackend(width, height int) *BufferBackend {
+	b := &BufferBackend{
+		width:         width,
+		height:        height,
+		cells:         make([]Cell, width*height),
+		cursorVisible: true,
+	}
+	b.Clear(termbox.ColorDefault, termbox.ColorDefault)
+	return b
+}
+
+// Init reopens a closed buffer; the buffer is ready to draw to when created.
+// Like termbox, reopening hides the cursor.
+func (b *BufferBackend) Init() error {
+	b.closed = false
+	b.cursorVisible = false
+	return nil
+}
+
+// SetCell sets a cell in the buffer, ignoring out-of-bounds positions
+func (b *BufferBackend) SetCell(x, y int, ch rune, fg, bg termbox.Attribute) {
+	b.setCellCalls++
+	if x < 0 || x >= b.width || y < 0 || y >= b.height {
+		b.outOfBounds++
+		return
+	}
+	b.cells[y*b.width+x] = Cell{Ch: ch, Fg: fg, Bg: bg}
+}
+
+// Clear fills the buffer with blank cells
+func (b *BufferBackend) Clear(fg, bg termbox.Attribute) {
+	for i := range b.cells {
+		b.cells[i] = Cell{Ch: ' ', Fg: fg, Bg: bg}
+	}
+}
+
+// Flush records a flush; the buffer is always up to date
+func (b *BufferBackend) Flush() error {
+	b.flushCalls++
+	return nil
+}
+
+// Size returns the buffer size
+func (b *BufferBackend) Size() (int, int) {
+	return b.width, b.height
+}
+
+// SetCursor shows the cursor at the given position
+func (b *BufferBackend) SetCursor(x, y int) {
+	b.cursorX = x
+	b.cursorY = y
+	b.cursorVisible = true
+}
+
+// HideCursor hides the cursor
+func (b *BufferBackend) HideCursor() {
+	b.cursorVisible = false
+}
+
+// Cursor returns the cursor position and whether it is visible
+func (b *BufferBackend) Cursor() (x, y int, visible bool) {
+	return b.cursorX, b.cursorY, b.cursorVisible
+}
+
+// Close marks the buffer closed, keeping its contents. Like a terminal the
+// cursor shows again once the game lets go.
+func (b *BufferBackend) Close() {
+	b.closed = true
+	b.cursorVisible = true
+}
+
+// IsClosed returns whether Close has been called since the buffer was last initialized
+func (b *BufferBackend) IsClosed() bool {
+	return b.closed
+}
+
+// Cell returns the cell at the specified position
+func (b *BufferBackend) Cell(x, y int) Cell {
+	if x < 0 || x >= b.width || y < 0 || y >= b.height {
+		return Cell{}
+	}
+	return b.cells[y*b.width+x]
+}
+
+// Line returns the characters of row y as a string
+func (b *BufferBackend) Line(y int) string {
+	if y < 0 || y >= b.height {
+		return ""
+	}
+	runes := make([]rune, b.width)
+	for x := 0; x < b.width; x++ {
+		runes[x] = b.cells[y*b.width+x].Ch
+	}
+	return string(runes)
+}
+
+// SetCellCalls returns how many times SetCell has been called
+func (b *BufferBackend) SetCellCalls() int {
+	return b.setCellCalls
+}
+
+// OutOfBoundsWrites returns how many SetCell calls fell outside the buffer
+func (b *BufferBackend) OutOfBoundsWrites() int {
+	return b.outOfBounds
+}
+
+// FlushCalls returns how many times Flush has been called
+func (b *BufferBackend) FlushCalls() int {
+	return b.flushCalls
+}
+
+// ResetCounters resets the SetCell and Flush call counters
+func (b *BufferBackend) ResetCounters() {
+	b.setCellCalls = 0
+	b.outOfBounds = 0
+	b.flushCalls = 0
+}
@@ -0,0 +1,195 @@
+package render
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nsf/termbox-go"
+)
+
+// drawTestFrame draws a ground line and a small sprite, like a gameplay frame
+func drawTestFrame(r *Renderer, spriteX int) {
+	r.Clear()
+	for x := 0; x < r.width; x++ {
+		r.DrawAt(x, r.height-2, '-')
+	}
+	r.DrawString(spriteX, r.height-4, "####")
+	r.DrawString(spriteX, r.height-3, "#  #")
+	r.DrawScore(1234, 5678)
+	r.Flush()
+}
+
+func TestBufferBackendSetCell(t *testing.T) {
+	backend := NewBufferBackend(10, 5)
+
+	backend.SetCell(2, 3, 'X', termbox.ColorRed, termbox.ColorDefault)
+	cell := backend.Cell(2, 3)
+	if cell.Ch != 'X' || cell.Fg != termbox.ColorRed {
+		t.Errorf("Expected red 'X' at (2, 3), got %+v", cell)
+	}
+
+	// Out-of-bounds writes should be ignored without panicking
+	backend.SetCell(-1, 0, 'X', termbox.ColorDefault, termbox.ColorDefault)
+	backend.SetCell(10, 0, 'X', termbox.ColorDefault, termbox.ColorDefault)
+
+	if backend.SetCellCalls() != 3 {
+		t.Errorf("Expected 3 SetCell calls, got %d", backend.SetCellCalls())
+	}
+
+	backend.Clear(termbox.ColorDefault, termbox.ColorDefault)
+	if backend.Cell(2, 3).Ch != ' ' {
+		t.Errorf("Expected blank cell after Clear, got %q", backend.Cell(2, 3).Ch)
+	}
+}
+
+func TestRendererWithBufferBackend(t *testing.T) {
+	backend := NewBufferBackend(20, 5)
+	renderer := NewRendererWithBackend(backend)
+
+	width, height := renderer.GetSize()
+	if width != 20 || height != 5 {
+		t.Errorf("Expected size (20, 5) from backend, got (%d, %d)", width, height)
+	}
+
+	renderer.DrawString(2, 1, "Hello")
+	renderer.Flush()
+
+	if line := backend.Line(1); line[2:7] != "Hello" {
+		t.Errorf("Expected 'Hello' at column 2, got line %q", line)
+	}
+	if backend.FlushCalls() != 1 {
+		t.Errorf("Expected 1 flush, got %d", backend.FlushCalls())
+	}
+}
+
+func TestDiffRenderingMatchesFullRendering(t *testing.T) {
+	fullBackend := NewBufferBackend(40, 10)
+	fullRenderer := NewRendererWithBackend(fullBackend)
+
+	diffBackend := NewBufferBackend(40, 10)
+	diffRenderer := NewRendererWithBackend(diffBackend)
+	diffRenderer.SetDiffRendering(true)
+
+	for frame := 0; frame < 5; frame++ {
+		drawTestFrame(fullRenderer, 10+frame)
+		drawTestFrame(diffRenderer, 10+frame)
+
+		for y := 0; y < 10; y++ {
+			if fullBackend.Line(y) != diffBackend.Line(y) {
+				t.Fatalf("Frame %d line %d: expected %q, got %q", frame, y, fullBackend.Line(y), diffBackend.Line(y))
+			}
+		}
+	}
+}
+
+func TestDiffRenderingOnlySendsChangedCells(t *testing.T) {
+	fullBackend := NewBufferBackend(40, 10)
+	fullRenderer := NewRendererWithBackend(fullBackend)
+
+	diffBackend := NewBufferBackend(40, 10)
+	diffRenderer := NewRendererWithBackend(diffBackend)
+	diffRenderer.SetDiffRendering(true)
+
+	// First frame sends every cell in diff mode
+	drawTestFrame(diffRenderer, 10)
+	if diffBackend.SetCellCalls() != 40*10 {
+		t.Errorf("Expected full redraw of %d cells on first frame, got %d", 40*10, diffBackend.SetCellCalls())
+	}
+
+	drawTestFrame(fullRenderer, 10)
+	fullBackend.ResetCounters()
+	diffBackend.ResetCounters()
+
+	// Move the sprite one column to the right
+	drawTestFrame(fullRenderer, 11)
+	drawTestFrame(diffRenderer, 11)
+
+	// Only the sprite cells change: 2 on the solid row and 4 on the hollow row
+	if diffBackend.SetCellCalls() != 6 {
+		t.Errorf("Expected 6 changed cells, got %d", diffBackend.SetCellCalls())
+	}
+	if diffBackend.SetCellCalls() >= fullBackend.SetCellCalls() {
+		t.Errorf("Expected diff rendering (%d SetCell calls) to send fewer cells than full rendering (%d)",
+			diffBackend.SetCellCalls(), fullBackend.SetCellCalls())
+	}
+
+	// An unchanged frame sends nothing
+	diffBackend.ResetCounters()
+	drawTestFrame(diffRenderer, 11)
+	if diffBackend.SetCellCalls() != 0 {
+		t.Errorf("Expected no SetCell calls for an unchanged frame, got %d", diffBackend.SetCellCalls())
+	}
+}
+
+func BenchmarkRendererFullRedraw(b *testing.B) {
+	backend := NewBufferBackend(200, 60)
+	renderer := NewRendererWithBackend(backend)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		drawTestFrame(renderer, 10+i%50)
+	}
+	b.ReportMetric(float64(backend.SetCellCalls())/float64(b.N), "setcells/op")
+}
+
+func BenchmarkRendererDiffRedraw(b *testing.B) {
+	backend := NewBufferBackend(200, 60)
+	renderer := NewRendererWithBackend(backend)
+	renderer.SetDiffRendering(true)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		drawTestFrame(renderer, 10+i%50)
+	}
+	b.ReportMetric(float64(backend.SetCellCalls())/float64(b.N), "setcells/op")
+}
+
+func TestAdaptiveQualityReducesDensityOnSlowFrames(t *testing.T) {
+	renderer := NewRendererWithBackend(NewBufferBackend(40, 10))
+	renderer.SetFrameBudget(33 * time.Millisecond)
+
+	var levels []float64
+	renderer.SetQualityCallback(func(level float64) {
+		levels = append(levels, level)
+	})
+
+	// Simulate a run of slow frames
+	for i := 0; i < 5; i++ {
+		renderer.RecordFrameTime(50 * time.Millisecond)
+	}
+
+	if renderer.GetQualityLevel() != minQualityLevel {
+		t.Errorf("Expected quality to bottom out at %f, got %f", minQualityLevel, renderer.GetQualityLevel())
+	}
+	expected := []float64{0.75, 0.5, 0.25}
+	if len(levels) != len(expected) {
+		t.Fatalf("Expected %d quality changes, got %v", len(expected), levels)
+	}
+	for i, level := range expected {
+		if levels[i] != level {
+			t.Errorf("Change %d: expected level %f, got %f", i, level, levels[i])
+		}
+	}
+
+	// Fast frames gradually restore detail
+	for i := 0; i < 100; i++ {
+		renderer.RecordFrameTime(5 * time.Millisecond)
+	}
+	if renderer.GetQualityLevel() != 1.0 {
+		t.Errorf("Expected quality to recover to 1.0, got %f", renderer.GetQualityLevel())
+	}
+}
+
+func TestAdaptiveQualityDisabledWithoutBudget(t *testing.T) {
+	renderer := NewRendererWithBackend(NewBufferBackend(40, 10))
+
+	called := false
+	renderer.SetQualityCallback(func(level float64) {
+		called = true
+	})
+	renderer.RecordFrameTime(time.Second)
+
+	if called || renderer.GetQualityLevel() != 1.0 {
+		t.Error("Adaptive quality should be disabled when no frame budget is set")
+	}
+}
@@ -0,0 +1,36 @@
+package render
+
+import "strings"
+
+// DetectUnicodeSupport guesses whether the terminal can display Unicode characters
+// by inspecting the locale and terminal environment variables. The getenv function
+// is usually os.Getenv and is injectable for testing.
+func DetectUnicodeSupport(getenv func(string) string) bool {
+	// Terminals known to lack Unicode glyphs regardless of locale
+	switch getenv("TERM") {
+	case "dumb", "linux", "vt100", "vt220":
+		return false
+	}
+
+	// The first non-empty locale variable wins, following POSIX precedence
+	for _, name := range []string{"LC_ALL", "LC_CTYPE", "LANG"} {
+		if locale := getenv(name); locale != "" {
+			return isUTF8Locale(locale)
+		}
+	}
+
+	// No locale information (common on Windows); keep the Unicode default
+	return true
+}
+
+// NoColorRequested reports whether the user asked for output without color by
+// setting NO_COLOR to any non-empty value (see https://no-color.org)
+func NoColorRequested(getenv func(string) string) bool {
+	return getenv("NO_COLOR") != ""
+}
+
+// isUTF8Locale reports whether a locale string such as "en_US.UTF-8" uses UTF-8 encoding
+func isUTF8Locale(locale string) bool {
+	normalized := strings.ToLower(strings.ReplaceAll(locale, "-", ""))
+	return strings.Contains(normalized, "utf8")
+}
@@ -0,0 +1,34 @@
+package render
+
+import "testing"
+
+func TestDetectUnicodeSupport(t *testing.T) {
+	tests := []struct {
+		name     string
+		env      map[string]string
+		expected bool
+	}{
+		{"UTF-8 LANG", map[string]string{"LANG": "en_US.UTF-8", "TERM": "xterm-256color"}, true},
+		{"lowercase utf8 LANG", map[string]string{"LANG": "de_DE.utf8"}, true},
+		{"C locale", map[string]string{"LANG": "C", "TERM": "xterm"}, false},
+		{"POSIX locale", map[string]string{"LANG": "POSIX"}, false},
+		{"Latin-1 locale", map[string]string{"LANG": "en_US.ISO-8859-1"}, false},
+		{"LC_ALL overrides LANG", map[string]string{"LC_ALL": "C", "LANG": "en_US.UTF-8"}, false},
+		{"LC_CTYPE overrides LANG", map[string]string{"LC_CTYPE": "en_US.UTF-8", "LANG": "C"}, true},
+		{"linux console", map[string]string{"LANG": "en_US.UTF-8", "TERM": "linux"}, false},
+		{"dumb terminal", map[string]string{"TERM": "dumb"}, false},
+		{"no environment", map[string]string{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			getenv := func(key string) string {
+				return tt.env[key]
+			}
+			result := DetectUnicodeSupport(getenv)
+			if result != tt.expected {
+				t.Errorf("Expected %t, got %t", tt.expected, result)
+			}
+		})
+	}
+}
@@ -8,6 +8,8 @@
 //   - Screen clearing and cursor positioning
 //   - Buffer-based rendering for smooth updates
 //   - Drawing primitives (characters, strings, boxes)
+//   - Pluggable output backends (termbox, or an in-memory buffer for tests)
+//   - Optional diff rendering that only sends changed cells each frame
 //   - Terminal size detection and handling
 //
 // The main type is Renderer, which manages the terminal state and provides
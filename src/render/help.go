@@ -0,0 +1,76 @@
+package render
+
+import "fmt"
+
+// HelpEntry is one obstacle listed on the help screen
+type HelpEntry struct {
+	Name     string
+	Width    float64
+	Height   float64
+	Jumpable bool // Cleared by jumping
+	Duckable bool // Passes overhead of a dinosaur on the ground
+}
+
+// HelpLine returns the help screen row for an entry
+func HelpLine(entry HelpEntry) string {
+	var how string
+	switch {
+	case entry.Jumpable && entry.Duckable:
+		how = "jump or run under"
+	case entry.Jumpable:
+		how = "jump"
+	case entry.Duckable:
+		how = "run under"
+	default:
+		how = "avoid"
+	}
+	size := fmt.Sprintf("%gx%g", entry.Width, entry.Height)
+	return fmt.Sprintf("%-14s %-5s %s", entry.Name, size, how)
+}
+
+// HelpControl is one key binding listed on the help screen
+type HelpControl struct {
+	Keys   string
+	Action string
+}
+
+// DrawHelp lists the controls, then the obstacles with their sizes and how to
+// get past them
+func (r *Renderer) DrawHelp(controls []HelpControl, entries []HelpEntry) {
+	r.Clear()
+
+	lines := []string{"HELP", "", "Controls"}
+	for _, control := range controls {
+		lines = append(lines, fmt.Sprintf("  %-14s %s", control.Keys, control.Action))
+	}
+	lines = append(lines, "", "Obstacles")
+	for _, entry := range entries {
+		lines = append(lines, "  "+HelpLine(entry))
+	}
+	lines = append(lines, "", "Press any key to return to the menu")
+
+	// Left-align the rows in a block centered on the screen
+	blockWidth := 0
+	for _, line := range lines {
+		if len(line) > blockWidth {
+			blockWidth = len(line)
+		}
+	}
+	centerX := r.width / 2
+	x := centerX - blockWidth/2
+	if x < 0 {
+		x = 0
+	}
+	startY := r.height/2 - len(lines)/2
+	for i, line := range lines {
+		y := startY + i
+		if y < 0 || y >= r.height {
+			continue
+		}
+		if i == 0 {
+			r.DrawStringWithColor(centerX-len(line)/2, y, line, "bold")
+			continue
+		}
+		r.DrawString(x, y, line)
+	}
+}
@@ -0,0 +1,57 @@
+package render
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestHelpLine(t *testing.T) {
+	tests := []struct {
+		entry    HelpEntry
+		expected string
+	}{
+		{HelpEntry{Name: "Small cactus", Width: 3, Height: 3, Jumpable: true}, "Small cactus   3x3   jump"},
+		{HelpEntry{Name: "High bird", Width: 4, Height: 2, Duckable: true}, "High bird      4x2   run under"},
+		{HelpEntry{Name: "Mid bird", Width: 4, Height: 2, Jumpable: true, Duckable: true}, "Mid bird       4x2   jump or run under"},
+		{HelpEntry{Name: "Wall", Width: 1, Height: 10}, "Wall           1x10  avoid"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.entry.Name, func(t *testing.T) {
+			if line := HelpLine(tt.entry); line != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, line)
+			}
+		})
+	}
+}
+
+func TestDrawHelp(t *testing.T) {
+	backend := NewBufferBackend(80, 30)
+	renderer := NewRendererWithBackend(backend)
+
+	controls := []HelpControl{
+		{Keys: "Space/Up", Action: "Jump / start"},
+		{Keys: "H", Action: "Help"},
+	}
+	entries := []HelpEntry{
+		{Name: "Small cactus", Width: 3, Height: 3, Jumpable: true},
+		{Name: "Low bird", Width: 4, Height: 2, Jumpable: true},
+	}
+	renderer.DrawHelp(controls, entries)
+
+	var screen strings.Builder
+	for y := 0; y < 30; y++ {
+		screen.WriteString(backend.Line(y))
+		screen.WriteString("\n")
+	}
+	text := screen.String()
+
+	for _, want := range []string{
+		"HELP", "Controls", "Space/Up       Jump / start", "H              Help",
+		"Obstacles", "Small cactus   3x3   jump", "Low bird       4x2   jump", "Press any key",
+	} {
+		if !strings.Contains(text, want) {
+			t.Errorf("Expected help screen to contain %q, got:\n%s", want, text)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package render
+
+import "fmt"
+
+// DrawTurnOver renders the screen between hotseat turns: the scores so far and
+// a prompt for the next player to start their run
+func (r *Renderer) DrawTurnOver(scores []int, nextPlayer int) {
+	lines := append([]string{"TURN OVER", ""}, playerScoreLines(scores)...)
+	lines = append(lines, "", fmt.Sprintf("Player %d, press Space to start", nextPlayer))
+	r.drawTitledBlock(lines)
+}
+
+// DrawHotseatResults renders the end of a hotseat match: every player's score
+// and the winner, the player with the highest score
+func (r *Renderer) DrawHotseatResults(scores []int, winner int, tie bool) {
+	result := fmt.Sprintf("Player %d wins!", winner)
+	if tie {
+		result = "It's a tie!"
+	}
+	lines := append([]string{"RESULTS", ""}, playerScoreLines(scores)...)
+	lines = append(lines, "", result, "", "Press any key to return to the menu")
+	r.drawTitledBlock(lines)
+}
+
+// playerScoreLines lists each player's score, counting players from 1
+func playerScoreLines(scores []int) []string {
+	lines := make([]string, len(scores))
+	for i, score := range scores {
+		lines[i] = fmt.Sprintf("Player %d: %6d", i+1, score)
+	}
+	return lines
+}
+
+// drawTitledBlock clears the screen and draws lines as a left-aligned block
+// centered on it, with the first line as a bold centered title
+func (r *Renderer) drawTitledBlock(lines []string) {
+	r.Clear()
+
+	centerX := r.width / 2
+	centerY := r.height / 2
+
+	blockWidth := 0
+	for _, line := range lines {
+		if len(line) > blockWidth {
+			blockWidth = len(line)
+		}
+	}
+	x := max(centerX-blockWidth/2, 0)
+	startY := centerY - len(lines)/2
+	for i, line := range lines {
+		y := startY + i
+		if y < 0 || y >= r.height {
+			continue
+		}
+		if i == 0 {
+			r.DrawStringWithColor(centerX-len(line)/2, y, line, "bold")
+			continue
+		}
+		r.DrawString(x, y, line)
+	}
+}
+
+// DrawPlayerTurn shows whose turn it is during a hotseat run, under the time
+// attack countdown
+func (r *Renderer) DrawPlayerTurn(player int) {
+	if r.isCompactHUD() {
+		text := fmt.Sprintf("P%d", player)
+		if len(text) <= r.width {
+			r.DrawString(0, 0, text)
+		}
+		return
+	}
+
+	text := fmt.Sprintf("Player %d", player)
+	if len(text)+1 < r.width && r.height > 1 {
+		r.DrawString(1, 1, text)
+	}
+}
@@ -0,0 +1,58 @@
+package render
+
+import (
+	"strings"
+	"testing"
+)
+
+// screenText returns everything drawn to a buffer backend of the given height
+func screenText(backend *BufferBackend, height int) string {
+	var screen strings.Builder
+	for y := 0; y < height; y++ {
+		screen.WriteString(backend.Line(y))
+		screen.WriteString("\n")
+	}
+	return screen.String()
+}
+
+func TestDrawTurnOver(t *testing.T) {
+	backend := NewBufferBackend(80, 24)
+	renderer := NewRendererWithBackend(backend)
+
+	renderer.DrawTurnOver([]int{420}, 2)
+
+	text := screenText(backend, 24)
+	for _, want := range []string{"TURN OVER", "Player 1:    420", "Player 2, press Space to start"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("Expected turn over screen to contain %q, got:\n%s", want, text)
+		}
+	}
+}
+
+func TestDrawHotseatResults(t *testing.T) {
+	tests := []struct {
+		name   string
+		winner int
+		tie    bool
+		want   string
+	}{
+		{"winner", 2, false, "Player 2 wins!"},
+		{"tie", 1, true, "It's a tie!"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			backend := NewBufferBackend(80, 24)
+			renderer := NewRendererWithBackend(backend)
+
+			renderer.DrawHotseatResults([]int{150, 300}, tt.winner, tt.tie)
+
+			text := screenText(backend, 24)
+			for _, want := range []string{"RESULTS", "Player 1:    150", "Player 2:    300", tt.want, "Press any key"} {
+				if !strings.Contains(text, want) {
+					t.Errorf("Expected results screen to contain %q, got:\n%s", want, text)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package render
+
+import "fmt"
+
+// LeaderboardEntry is one ranked score on the leaderboard
+type LeaderboardEntry struct {
+	Label string
+	Score int
+}
+
+// DrawLeaderboard lists the best scores, highest first
+func (r *Renderer) DrawLeaderboard(entries []LeaderboardEntry) {
+	lines := []string{"LEADERBOARD", ""}
+	if len(entries) == 0 {
+		lines = append(lines, "No scores yet")
+	}
+	for i, entry := range entries {
+		lines = append(lines, fmt.Sprintf("%2d. %-12s %6d", i+1, entry.Label, entry.Score))
+	}
+	lines = append(lines, "", "Press any key to return to the menu")
+	r.drawTitledBlock(lines)
+}
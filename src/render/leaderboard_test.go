@@ -0,0 +1,33 @@
+package render
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDrawLeaderboard(t *testing.T) {
+	tests := []struct {
+		name    string
+		entries []LeaderboardEntry
+		want    []string
+	}{
+		{"ranked", []LeaderboardEntry{{"Best run", 900}, {"Seed 42", 300}}, []string{"LEADERBOARD", " 1. Best run        900", " 2. Seed 42         300"}},
+		{"empty", nil, []string{"LEADERBOARD", "No scores yet"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			backend := NewBufferBackend(80, 24)
+			renderer := NewRendererWithBackend(backend)
+
+			renderer.DrawLeaderboard(tt.entries)
+
+			text := screenText(backend, 24)
+			for _, want := range append(tt.want, "Press any key") {
+				if !strings.Contains(text, want) {
+					t.Errorf("Expected leaderboard to contain %q, got:\n%s", want, text)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package render
+
+// Menu is a vertical list of selectable items with a highlighted selection
+type Menu struct {
+	items    []string
+	selected int
+}
+
+// NewMenu creates a menu with the given items, selecting the first one
+func NewMenu(items ...string) *Menu {
+	return &Menu{
+		items:    items,
+		selected: 0,
+	}
+}
+
+// MoveUp selects the previous item, wrapping around to the last
+func (m *Menu) MoveUp() {
+	if len(m.items) == 0 {
+		return
+	}
+	m.selected = (m.selected - 1 + len(m.items)) % len(m.items)
+}
+
+// MoveDown selects the next item, wrapping around to the first
+func (m *Menu) MoveDown() {
+	if len(m.items) == 0 {
+		return
+	}
+	m.selected = (m.selected + 1) % len(m.items)
+}
+
+// Selected returns the currently selected item, or "" if the menu is empty
+func (m *Menu) Selected() string {
+	if len(m.items) == 0 {
+		return ""
+	}
+	return m.items[m.selected]
+}
+
+// SelectedIndex returns the index of the currently selected item
+func (m *Menu) SelectedIndex() int {
+	return m.selected
+}
+
+// Select selects the item with the given label, returning false if it does not exist
+func (m *Menu) Select(item string) bool {
+	for i, label := range m.items {
+		if label == item {
+			m.selected = i
+			return true
+		}
+	}
+	return false
+}
+
+// SetItems replaces the menu items, keeping the selected index where possible
+func (m *Menu) SetItems(items ...string) {
+	m.items = items
+	if m.selected >= len(items) {
+		m.selected = 0
+	}
+}
+
+// Items returns the menu items
+func (m *Menu) Items() []string {
+	return m.items
+}
@@ -0,0 +1,115 @@
+package render
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/nsf/termbox-go"
+)
+
+func TestMenuNavigationWrapsAround(t *testing.T) {
+	menu := NewMenu("Start", "Settings", "Quit")
+
+	if menu.Selected() != "Start" {
+		t.Errorf("Expected first item to be selected initially, got %s", menu.Selected())
+	}
+
+	menu.MoveUp()
+	if menu.Selected() != "Quit" {
+		t.Errorf("Expected MoveUp from the first item to wrap to Quit, got %s", menu.Selected())
+	}
+
+	menu.MoveDown()
+	if menu.Selected() != "Start" {
+		t.Errorf("Expected MoveDown from the last item to wrap to Start, got %s", menu.Selected())
+	}
+
+	menu.MoveDown()
+	if menu.Selected() != "Settings" || menu.SelectedIndex() != 1 {
+		t.Errorf("Expected Settings at index 1, got %s at %d", menu.Selected(), menu.SelectedIndex())
+	}
+}
+
+func TestMenuSelect(t *testing.T) {
+	menu := NewMenu("Start", "Settings", "Quit")
+
+	if !menu.Select("Quit") || menu.Selected() != "Quit" {
+		t.Errorf("Expected Select to choose Quit, got %s", menu.Selected())
+	}
+	if menu.Select("Missing") {
+		t.Error("Select should return false for an unknown item")
+	}
+	if menu.Selected() != "Quit" {
+		t.Errorf("Selection should be unchanged after a failed Select, got %s", menu.Selected())
+	}
+}
+
+func TestEmptyMenu(t *testing.T) {
+	menu := NewMenu()
+
+	// Navigation on an empty menu should not panic
+	menu.MoveUp()
+	menu.MoveDown()
+	if menu.Selected() != "" {
+		t.Errorf("Expected empty selection, got %q", menu.Selected())
+	}
+}
+
+func TestDrawMenuHighlightsSelection(t *testing.T) {
+	backend := NewBufferBackend(80, 24)
+	renderer := NewRendererWithBackend(backend)
+	menu := NewMenu("Start", "Quit")
+	menu.MoveDown()
+
+	renderer.DrawMenu(menu)
+
+	itemStartY := 24/2 + 4
+	startLine := backend.Line(itemStartY)
+	quitLine := backend.Line(itemStartY + 1)
+
+	if !strings.Contains(startLine, "  Start  ") || strings.Contains(startLine, ">") {
+		t.Errorf("Expected unselected Start item, got %q", startLine)
+	}
+	if !strings.Contains(quitLine, "> Quit <") {
+		t.Errorf("Expected selected Quit item, got %q", quitLine)
+	}
+
+	quitX := strings.Index(quitLine, "Quit")
+	if backend.Cell(quitX, itemStartY+1).Fg&termbox.AttrBold == 0 {
+		t.Error("Expected selected item to be drawn bold")
+	}
+}
+
+func TestDrawSettingsScreen(t *testing.T) {
+	backend := NewBufferBackend(80, 24)
+	renderer := NewRendererWithBackend(backend)
+	menu := NewMenu("Unicode: On", "Sound: Off", "Difficulty: Hard", "Back")
+
+	renderer.DrawSettingsScreen(menu)
+
+	var screen strings.Builder
+	for y := 0; y < 24; y++ {
+		screen.WriteString(backend.Line(y))
+		screen.WriteString("\n")
+	}
+	for _, want := range []string{"SETTINGS", "> Unicode: On <", "Sound: Off", "Difficulty: Hard", "ENTER: Change"} {
+		if !strings.Contains(screen.String(), want) {
+			t.Errorf("Expected settings screen to contain %q", want)
+		}
+	}
+}
+
+func TestMenuSetItemsKeepsSelection(t *testing.T) {
+	menu := NewMenu("Unicode: On", "Sound: On", "Back")
+	menu.MoveDown()
+
+	menu.SetItems("Unicode: On", "Sound: Off", "Back")
+	if menu.Selected() != "Sound: Off" {
+		t.Errorf("Expected selection to stay on the same row, got %s", menu.Selected())
+	}
+
+	menu.SetItems("Only")
+	if menu.SelectedIndex() != 0 {
+		t.Errorf("Expected selection to reset when out of range, got %d", menu.SelectedIndex())
+	}
+}
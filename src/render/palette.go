@@ -0,0 +1,74 @@
+package render
+
+import (
+	"math"
+
+	"github.com/nsf/termbox-go"
+)
+
+// palette maps color names to the terminal attributes they are drawn with
+var palette = map[string]termbox.Attribute{
+	"default": termbox.ColorDefault,
+	"bold":    termbox.ColorDefault | termbox.AttrBold,
+	"ash":     termbox.ColorWhite | termbox.AttrDim, // Dimmed white for subtle grey
+	"grey":    termbox.ColorWhite | termbox.AttrDim,
+	"gray":    termbox.ColorWhite | termbox.AttrDim,
+	"dark":    termbox.ColorBlack,
+	"red":     termbox.ColorRed,
+	"green":   termbox.ColorGreen,
+	"yellow":  termbox.ColorYellow,
+	"blue":    termbox.ColorBlue,
+	"magenta": termbox.ColorMagenta,
+	"cyan":    termbox.ColorCyan,
+	"white":   termbox.ColorWhite,
+}
+
+// highContrastPalette swaps the dim and dark colors, which are hard to tell from
+// the background, for the terminal's own foreground
+var highContrastPalette = map[string]termbox.Attribute{
+	"ash":  termbox.ColorDefault,
+	"grey": termbox.ColorDefault,
+	"gray": termbox.ColorDefault,
+	"dark": termbox.ColorDefault,
+}
+
+// ResolveColor returns the attribute a palette color is drawn with. Unknown names
+// resolve to the default color.
+func ResolveColor(name string) (termbox.Attribute, bool) {
+	attr, ok := palette[name]
+	if !ok {
+		return termbox.ColorDefault, false
+	}
+	return attr, true
+}
+
+// DifficultyColor returns the color in ramp for how far difficulty has
+// progressed, from 0 for the first color to 1 for the last. An empty ramp
+// returns the default color.
+func DifficultyColor(ramp []string, progress float64) string {
+	if len(ramp) == 0 {
+		return "default"
+	}
+	progress = math.Max(0, math.Min(1, progress))
+	index := int(progress * float64(len(ramp)))
+	if index >= len(ramp) {
+		index = len(ramp) - 1
+	}
+	return ramp[index]
+}
+
+// resolveHighContrastColor returns the attribute a palette color is drawn with in
+// high contrast mode
+func resolveHighContrastColor(name string) termbox.Attribute {
+	if attr, ok := highContrastPalette[name]; ok {
+		return attr
+	}
+	attr, _ := ResolveColor(name)
+	return attr
+}
+
+// withoutColor strips the color from an attribute, keeping text attributes such
+// as bold. Colors occupy the bits below the first attribute.
+func withoutColor(attr termbox.Attribute) termbox.Attribute {
+	return attr &^ (termbox.AttrBold - 1)
+}
@@ -0,0 +1,117 @@
+package render
+
+import (
+	"cli-dino-game/src/engine"
+	"testing"
+
+	"github.com/nsf/termbox-go"
+)
+
+func TestResolveColor(t *testing.T) {
+	// Every color the config accepts must be drawable
+	for _, color := range engine.PaletteColors {
+		if _, ok := ResolveColor(color); !ok {
+			t.Errorf("Expected palette color %q to resolve", color)
+		}
+	}
+
+	if attr, ok := ResolveColor("purple"); ok || attr != termbox.ColorDefault {
+		t.Errorf("Expected an unknown color to fall back to the default, got %v, %v", attr, ok)
+	}
+}
+
+func TestDifficultyColor(t *testing.T) {
+	ramp := []string{"green", "yellow", "red"}
+	tests := []struct {
+		progress float64
+		expected string
+	}{
+		{-0.5, "green"},
+		{0, "green"},
+		{0.2, "green"},
+		{0.4, "yellow"},
+		{0.6, "yellow"},
+		{0.7, "red"},
+		{1, "red"},
+		{2, "red"},
+	}
+
+	for _, tt := range tests {
+		if color := DifficultyColor(ramp, tt.progress); color != tt.expected {
+			t.Errorf("Progress %g: expected %q, got %q", tt.progress, tt.expected, color)
+		}
+		if _, ok := ResolveColor(DifficultyColor(ramp, tt.progress)); !ok {
+			t.Errorf("Progress %g: expected a palette color", tt.progress)
+		}
+	}
+
+	if color := DifficultyColor(nil, 0.5); color != "default" {
+		t.Errorf("Expected an empty ramp to give the default color, got %q", color)
+	}
+	if color := DifficultyColor([]string{"blue"}, 1); color != "blue" {
+		t.Errorf("Expected a one-color ramp to stay on that color, got %q", color)
+	}
+}
+
+func TestDrawStringWithColorUsesPalette(t *testing.T) {
+	backend := NewBufferBackend(20, 5)
+	renderer := NewRendererWithBackend(backend)
+	renderer.SetNoColor(false)
+
+	renderer.DrawStringWithColor(0, 0, "ab", "green")
+	if fg := backend.Cell(1, 0).Fg; fg != termbox.ColorGreen {
+		t.Errorf("Expected green foreground, got %v", fg)
+	}
+}
+
+func TestNoColor(t *testing.T) {
+	t.Setenv("NO_COLOR", "1")
+	backend := NewBufferBackend(20, 5)
+	renderer := NewRendererWithBackend(backend)
+	if !renderer.IsNoColor() {
+		t.Fatal("Expected NO_COLOR to disable colors")
+	}
+
+	for i, color := range []string{"green", "red", "dark", "yellow"} {
+		renderer.DrawAtWithColor(i, 0, 'x', color)
+		if fg := backend.Cell(i, 0).Fg; fg != termbox.ColorDefault {
+			t.Errorf("Expected %s to draw in the default color with NO_COLOR set, got %v", color, fg)
+		}
+	}
+
+	// Text attributes are not colors and survive
+	renderer.DrawAtWithColor(0, 1, 'x', "bold")
+	if fg := backend.Cell(0, 1).Fg; fg != termbox.AttrBold {
+		t.Errorf("Expected bold to be kept with NO_COLOR set, got %v", fg)
+	}
+
+	// Colors can be forced back on
+	renderer.SetNoColor(false)
+	renderer.DrawAtWithColor(0, 2, 'x', "green")
+	if fg := backend.Cell(0, 2).Fg; fg != termbox.ColorGreen {
+		t.Errorf("Expected green once colors are re-enabled, got %v", fg)
+	}
+}
+
+func TestNoColorRequested(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected bool
+	}{
+		{"", false},
+		{"1", true},
+		{"0", true}, // Any non-empty value counts
+	}
+
+	for _, tt := range tests {
+		getenv := func(name string) string {
+			if name == "NO_COLOR" {
+				return tt.value
+			}
+			return ""
+		}
+		if got := NoColorRequested(getenv); got != tt.expected {
+			t.Errorf("NO_COLOR=%q: expected %v, got %v", tt.value, tt.expected, got)
+		}
+	}
+}
@@ -1,7 +1,12 @@
 package render
 
 import (
+	"cli-dino-game/src/clock"
 	"fmt"
+	"math"
+	"os"
+	"strings"
+	"time"
 
 	"github.com/nsf/termbox-go"
 )
@@ -10,42 +15,307 @@ import (
 type Renderer struct {
 	width  int
 	height int
+
+	// Output backend (termbox when nil)
+	backend Backend
+
+	// Diff rendering keeps the previous frame and only sends changed cells
+	diffMode    bool
+	frontBuffer []Cell // Last frame sent to the backend
+	backBuffer  []Cell // Frame currently being drawn
+	fullRedraw  bool   // Send every cell on the next flush
+
+	// High contrast draws everything bold, with solid borders and no dim colors
+	highContrast bool
+
+	// No color drops every color and keeps only text attributes such as bold
+	noColor bool
+
+	// Whether the terminal has been handed back to the shell by Suspend
+	suspended bool
+
+	// Text cursor, hidden unless a screen asks for it
+	cursorVisible bool
+	cursorX       int
+	cursorY       int
+
+	// Toasts waiting or on screen, oldest first, timed by clock
+	toasts []toast
+	clock  clock.Clock
+
+	// Adaptive quality lowers background detail when frames exceed the budget
+	frameBudget     time.Duration
+	frameStart      time.Time
+	qualityLevel    float64
+	onQualityChange func(level float64)
+}
+
+// Adaptive quality tuning
+const (
+	minQualityLevel     = 0.25 // Lowest detail level adaptive quality will reach
+	qualityDropStep     = 0.25 // Detail removed after an over-budget frame
+	qualityRecoverStep  = 0.05 // Detail restored after a comfortably fast frame
+	qualityRecoverRatio = 0.75 // Frames under this fraction of the budget count as fast
+)
+
+// highScorePulseFrames is how many frames "NEW HIGH SCORE!" stays in each bold/normal phase
+const highScorePulseFrames = 8
+
+// compactHUDWidth is the narrowest screen the full HUD fits on; narrower screens
+// get short labels on fewer rows
+const compactHUDWidth = 40
+
+// Score breakdown bar layout
+const (
+	scoreBarWidth = 40 // Cells between the bar's brackets, narrowed to fit small screens
+)
+
+// scoreBarSources are the score breakdown keys shown in the bar, in drawing
+// order, with the character and color each is drawn with
+var scoreBarSources = []struct {
+	key   string
+	fill  rune
+	color string
+}{
+	{"time", '=', "cyan"},
+	{"obstacles", '#', "yellow"},
+	{"distance", '~', "green"},
 }
 
 // NewRenderer creates a new renderer instance using termbox-go
 func NewRenderer() (*Renderer, error) {
 	// Initialize termbox
-	err := termbox.Init()
-	if err != nil {
+	backend := termboxBackend{}
+	if err := backend.Init(); err != nil {
 		return nil, fmt.Errorf("failed to initialize termbox: %w", err)
 	}
 
-	// Set input mode for better key handling
-	termbox.SetInputMode(termbox.InputEsc)
+	return NewRendererWithBackend(backend), nil
+}
+
+// NewRendererWithBackend creates a renderer that draws to the given backend
+func NewRendererWithBackend(backend Backend) *Renderer {
+	width, height := backend.Size()
 
-	// Get terminal size
-	width, height := termbox.Size()
+	// A blinking cursor in the middle of the game is distracting
+	backend.HideCursor()
 
 	return &Renderer{
-		width:  width,
-		height: height,
-	}, nil
+		width:        width,
+		height:       height,
+		backend:      backend,
+		qualityLevel: 1.0,
+		noColor:      NoColorRequested(os.Getenv),
+		clock:        clock.Real,
+	}
+}
+
+// output returns the backend cells are written to
+func (r *Renderer) output() Backend {
+	if r.backend == nil {
+		return termboxBackend{}
+	}
+	return r.backend
 }
 
 // Close closes the termbox and restores terminal
 func (r *Renderer) Close() {
-	termbox.Close()
+	r.output().Close()
+}
+
+// Suspend gives the terminal back to the shell, restoring its normal mode, so
+// the process can be stopped without leaving the shell garbled
+func (r *Renderer) Suspend() {
+	if r.suspended {
+		return
+	}
+	r.output().Close()
+	r.suspended = true
+}
+
+// Resume takes the terminal back after Suspend and picks up any resize made
+// meanwhile. The next flush redraws the whole screen.
+func (r *Renderer) Resume() error {
+	if !r.suspended {
+		return nil
+	}
+	if err := r.output().Init(); err != nil {
+		return fmt.Errorf("failed to reinitialize terminal: %w", err)
+	}
+	r.suspended = false
+
+	r.UpdateSize()
+	r.fullRedraw = true
+	r.applyCursor()
+	return nil
+}
+
+// IsSuspended returns whether the terminal has been given back to the shell
+func (r *Renderer) IsSuspended() bool {
+	return r.suspended
+}
+
+// SetCursorVisible shows or hides the text cursor, for screens that take typed
+// input. The cursor stays where SetCursorPosition last put it.
+func (r *Renderer) SetCursorVisible(visible bool) {
+	r.cursorVisible = visible
+	r.applyCursor()
+}
+
+// IsCursorVisible returns whether the text cursor is shown
+func (r *Renderer) IsCursorVisible() bool {
+	return r.cursorVisible
+}
+
+// SetCursorPosition moves the text cursor, which only shows while visible
+func (r *Renderer) SetCursorPosition(x, y int) {
+	r.cursorX = x
+	r.cursorY = y
+	r.applyCursor()
+}
+
+// GetCursorPosition returns where the text cursor is placed
+func (r *Renderer) GetCursorPosition() (int, int) {
+	return r.cursorX, r.cursorY
+}
+
+// applyCursor sends the cursor state to the backend
+func (r *Renderer) applyCursor() {
+	if r.suspended {
+		return
+	}
+	if r.cursorVisible {
+		r.output().SetCursor(r.cursorX, r.cursorY)
+	} else {
+		r.output().HideCursor()
+	}
+}
+
+// SetDiffRendering enables or disables diff rendering, where the renderer keeps
+// the previous frame and only sends changed cells to the backend on Flush
+func (r *Renderer) SetDiffRendering(enabled bool) {
+	r.diffMode = enabled
+	r.frontBuffer = nil
+	r.backBuffer = nil
+	if enabled {
+		r.allocateBuffers()
+	}
+}
+
+// IsDiffRendering returns whether diff rendering is enabled
+func (r *Renderer) IsDiffRendering() bool {
+	return r.diffMode
+}
+
+// SetHighContrast enables or disables high contrast drawing, where every cell is
+// bold, dim colors are brightened, and the border is solid
+func (r *Renderer) SetHighContrast(enabled bool) {
+	r.highContrast = enabled
+}
+
+// IsHighContrast returns whether high contrast drawing is enabled
+func (r *Renderer) IsHighContrast() bool {
+	return r.highContrast
+}
+
+// SetNoColor enables or disables drawing without color. It starts enabled when
+// the NO_COLOR environment variable is set.
+func (r *Renderer) SetNoColor(enabled bool) {
+	r.noColor = enabled
+}
+
+// IsNoColor returns whether colors are being dropped
+func (r *Renderer) IsNoColor() bool {
+	return r.noColor
+}
+
+// allocateBuffers sizes the frame buffers to the screen and forces a full redraw
+func (r *Renderer) allocateBuffers() {
+	size := r.width * r.height
+	r.frontBuffer = make([]Cell, size)
+	r.backBuffer = make([]Cell, size)
+	for i := range r.backBuffer {
+		r.backBuffer[i] = blankCell
+	}
+	r.fullRedraw = true
+}
+
+// SetFrameBudget sets the target frame time for adaptive quality (0 disables it)
+func (r *Renderer) SetFrameBudget(budget time.Duration) {
+	r.frameBudget = budget
+}
+
+// GetFrameBudget returns the target frame time for adaptive quality
+func (r *Renderer) GetFrameBudget() time.Duration {
+	return r.frameBudget
+}
+
+// SetQualityCallback sets a callback invoked with the new detail level (0.25-1.0)
+// whenever adaptive quality changes it, e.g. to thin background elements
+func (r *Renderer) SetQualityCallback(callback func(level float64)) {
+	r.onQualityChange = callback
+}
+
+// GetQualityLevel returns the current adaptive detail level (1.0 is full detail)
+func (r *Renderer) GetQualityLevel() float64 {
+	return r.qualityLevel
+}
+
+// RecordFrameTime feeds a measured frame time to adaptive quality. Frames over
+// budget lower the detail level; comfortably fast frames slowly restore it.
+func (r *Renderer) RecordFrameTime(frameTime time.Duration) {
+	if r.frameBudget <= 0 {
+		return
+	}
+
+	level := r.qualityLevel
+	if frameTime > r.frameBudget {
+		level -= qualityDropStep
+	} else if float64(frameTime) < float64(r.frameBudget)*qualityRecoverRatio {
+		level += qualityRecoverStep
+	}
+	level = math.Max(minQualityLevel, math.Min(1.0, level))
+
+	if level != r.qualityLevel {
+		r.qualityLevel = level
+		if r.onQualityChange != nil {
+			r.onQualityChange(level)
+		}
+	}
 }
 
 // Clear clears the screen buffer
 func (r *Renderer) Clear() {
-	termbox.Clear(termbox.ColorDefault, termbox.ColorDefault)
+	r.frameStart = time.Now()
+	if r.diffMode {
+		for i := range r.backBuffer {
+			r.backBuffer[i] = blankCell
+		}
+		return
+	}
+	r.output().Clear(termbox.ColorDefault, termbox.ColorDefault)
+}
+
+// setCell writes a cell to the frame buffer in diff mode or straight to the backend
+func (r *Renderer) setCell(x, y int, char rune, fg, bg termbox.Attribute) {
+	if r.highContrast {
+		fg |= termbox.AttrBold
+	}
+	if r.noColor {
+		fg = withoutColor(fg)
+		bg = withoutColor(bg)
+	}
+	if r.diffMode {
+		r.backBuffer[y*r.width+x] = Cell{Ch: char, Fg: fg, Bg: bg}
+		return
+	}
+	r.output().SetCell(x, y, char, fg, bg)
 }
 
 // DrawAt draws a character at the specified position
 func (r *Renderer) DrawAt(x, y int, char rune) {
 	if x >= 0 && x < r.width && y >= 0 && y < r.height {
-		termbox.SetCell(x, y, char, termbox.ColorDefault, termbox.ColorDefault)
+		r.setCell(x, y, char, termbox.ColorDefault, termbox.ColorDefault)
 	}
 }
 
@@ -76,16 +346,11 @@ func (r *Renderer) DrawStringWithColor(x, y int, text string, color string) {
 // DrawAtWithColor draws a character at the specified position with color
 func (r *Renderer) DrawAtWithColor(x, y int, char rune, color string) {
 	if x >= 0 && x < r.width && y >= 0 && y < r.height {
-		var fg termbox.Attribute
-		switch color {
-		case "ash", "grey", "gray":
-			fg = termbox.ColorWhite | termbox.AttrDim // Dimmed white for subtle grey
-		case "dark":
-			fg = termbox.ColorBlack
-		default:
-			fg = termbox.ColorDefault
+		fg, _ := ResolveColor(color)
+		if r.highContrast {
+			fg = resolveHighContrastColor(color)
 		}
-		termbox.SetCell(x, y, char, fg, termbox.ColorDefault)
+		r.setCell(x, y, char, fg, termbox.ColorDefault)
 	}
 }
 
@@ -98,9 +363,69 @@ func (r *Renderer) DrawBox(x, y, width, height int, char rune) {
 	}
 }
 
+// DrawHitbox outlines a collision box over whatever is already drawn. A box one
+// cell wide or tall is drawn as a single line.
+func (r *Renderer) DrawHitbox(x, y, width, height int) {
+	if width <= 0 || height <= 0 {
+		return
+	}
+	right := x + width - 1
+	bottom := y + height - 1
+
+	if height == 1 {
+		for dx := x; dx <= right; dx++ {
+			r.DrawAtWithColor(dx, y, '─', "red")
+		}
+		return
+	}
+	if width == 1 {
+		for dy := y; dy <= bottom; dy++ {
+			r.DrawAtWithColor(x, dy, '│', "red")
+		}
+		return
+	}
+
+	for dx := x + 1; dx < right; dx++ {
+		r.DrawAtWithColor(dx, y, '─', "red")
+		r.DrawAtWithColor(dx, bottom, '─', "red")
+	}
+	for dy := y + 1; dy < bottom; dy++ {
+		r.DrawAtWithColor(x, dy, '│', "red")
+		r.DrawAtWithColor(right, dy, '│', "red")
+	}
+	r.DrawAtWithColor(x, y, '┌', "red")
+	r.DrawAtWithColor(right, y, '┐', "red")
+	r.DrawAtWithColor(x, bottom, '└', "red")
+	r.DrawAtWithColor(right, bottom, '┘', "red")
+}
+
 // Flush renders the buffer to the terminal (termbox handles double buffering)
 func (r *Renderer) Flush() {
-	termbox.Flush()
+	if r.diffMode {
+		r.flushChangedCells()
+	}
+	r.output().Flush()
+
+	if !r.frameStart.IsZero() {
+		r.RecordFrameTime(time.Since(r.frameStart))
+	}
+}
+
+// flushChangedCells sends only the cells that differ from the previous frame
+func (r *Renderer) flushChangedCells() {
+	out := r.output()
+	if r.fullRedraw {
+		out.Clear(termbox.ColorDefault, termbox.ColorDefault)
+	}
+
+	for i, cell := range r.backBuffer {
+		if r.fullRedraw || cell != r.frontBuffer[i] {
+			out.SetCell(i%r.width, i/r.width, cell.Ch, cell.Fg, cell.Bg)
+		}
+	}
+
+	copy(r.frontBuffer, r.backBuffer)
+	r.fullRedraw = false
 }
 
 // GetSize returns the current terminal size
@@ -110,14 +435,37 @@ func (r *Renderer) GetSize() (int, int) {
 
 // UpdateSize updates the renderer size (useful for handling terminal resize)
 func (r *Renderer) UpdateSize() error {
-	width, height := termbox.Size()
+	width, height := r.output().Size()
+	if width == r.width && height == r.height {
+		return nil
+	}
+
 	r.width = width
 	r.height = height
+	if r.diffMode {
+		r.allocateBuffers()
+	}
 	return nil
 }
 
-// DrawScore renders the current score and high score in the top-right corner
+// isCompactHUD reports whether the screen is too narrow for the full HUD
+func (r *Renderer) isCompactHUD() bool {
+	return r.width < compactHUDWidth
+}
+
+// DrawScore renders the current score and high score in the top-right corner.
+// Narrow screens get both on one short line, dropping the high score if even
+// that doesn't fit.
 func (r *Renderer) DrawScore(currentScore, highScore int) {
+	if r.isCompactHUD() {
+		text := fmt.Sprintf("S:%d H:%d", currentScore, highScore)
+		if len(text) > r.width {
+			text = fmt.Sprintf("S:%d", currentScore)
+		}
+		r.DrawString(max(r.width-len(text), 0), 0, text)
+		return
+	}
+
 	scoreText := fmt.Sprintf("Score: %d", currentScore)
 	highScoreText := fmt.Sprintf("High: %d", highScore)
 
@@ -133,8 +481,91 @@ func (r *Renderer) DrawScore(currentScore, highScore int) {
 	}
 }
 
-// DrawGameOverScreen renders the game over screen with final score
-func (r *Renderer) DrawGameOverScreen(finalScore, highScore int, isNewHighScore bool) {
+// DrawTimeRemaining renders the time attack countdown in the top-left corner
+func (r *Renderer) DrawTimeRemaining(remaining time.Duration) {
+	seconds := int(math.Ceil(remaining.Seconds()))
+	timeText := fmt.Sprintf("Time: %ds", seconds)
+
+	// Below the compact score line on narrow screens
+	if r.isCompactHUD() {
+		timeText = fmt.Sprintf("T:%ds", seconds)
+		if len(timeText) <= r.width {
+			r.DrawString(0, 1, timeText)
+		}
+		return
+	}
+
+	if len(timeText)+1 < r.width {
+		r.DrawString(1, 0, timeText)
+	}
+}
+
+// DrawGodMode renders a reminder at the top center that collisions are off
+func (r *Renderer) DrawGodMode() {
+	text := "GOD MODE"
+	x := (r.width - len(text)) / 2
+	if x >= 0 && r.height > 0 {
+		r.DrawStringWithColor(x, 0, text, "bold")
+	}
+}
+
+// DrawSeed renders the active RNG seed in the bottom-left corner so runs can be shared
+func (r *Renderer) DrawSeed(seed int64) {
+	seedText := fmt.Sprintf("Seed: %d", seed)
+
+	if len(seedText)+1 < r.width {
+		r.DrawString(1, r.height-1, seedText)
+	}
+}
+
+// DrawSeedComparison renders how the run compares to the best for its seed,
+// between the high score and the restart instructions on the game over screen
+func (r *Renderer) DrawSeedComparison(text string) {
+	x := r.width/2 - len(text)/2
+	if x >= 0 && x+len(text) < r.width {
+		r.DrawString(x, r.height/2+1, text)
+	}
+}
+
+// DrawUnranked renders why the run's score wasn't saved, dimmed, where the seed
+// comparison would go on the game over screen
+func (r *Renderer) DrawUnranked(reason string) {
+	x := r.width/2 - len(reason)/2
+	if x >= 0 && x+len(reason) < r.width {
+		r.DrawStringWithColor(x, r.height/2+1, reason, "ash")
+	}
+}
+
+// DrawObstacleWarning renders a "!" at the given position when an obstacle will arrive
+// within the threshold. A zero threshold disables the warning.
+func (r *Renderer) DrawObstacleWarning(x, y int, timeToReach, threshold time.Duration) {
+	if threshold <= 0 || timeToReach > threshold {
+		return
+	}
+	r.DrawAtWithColor(x, y, '!', "bold")
+}
+
+// DrawDebugInfo renders debug overlay lines down the left side, below the top HUD row
+func (r *Renderer) DrawDebugInfo(lines []string) {
+	for i, line := range lines {
+		y := 1 + i
+		if y >= r.height-1 {
+			break
+		}
+		r.DrawStringWithColor(1, y, line, "ash")
+	}
+}
+
+// ProgressBar returns a bar of the given width filled in proportion to progress (0 to 1)
+func ProgressBar(progress float64, width int) string {
+	progress = math.Max(0, math.Min(1, progress))
+	filled := int(math.Round(progress * float64(width)))
+	return "[" + strings.Repeat("#", filled) + strings.Repeat("-", width-filled) + "]"
+}
+
+// DrawGameOverScreen renders the game over screen with final score.
+// frame counts game over frames so a new high score can pulse.
+func (r *Renderer) DrawGameOverScreen(finalScore, highScore int, isNewHighScore bool, frame int) {
 	// Clear the screen first
 	r.Clear()
 
@@ -156,26 +587,252 @@ func (r *Renderer) DrawGameOverScreen(finalScore, highScore int, isNewHighScore
 		r.DrawString(scoreX, centerY-1, finalScoreText)
 	}
 
-	// High score or new high score message
+	// High score or new high score message, pulsing between bold and normal
 	var highScoreText string
+	highScoreColor := "default"
 	if isNewHighScore {
 		highScoreText = "NEW HIGH SCORE!"
+		if (frame/highScorePulseFrames)%2 == 0 {
+			highScoreColor = "bold"
+		}
 	} else {
 		highScoreText = fmt.Sprintf("High Score: %d", highScore)
 	}
 	highScoreX := centerX - len(highScoreText)/2
 	if highScoreX >= 0 && highScoreX+len(highScoreText) < r.width {
-		r.DrawString(highScoreX, centerY, highScoreText)
+		r.DrawStringWithColor(highScoreX, centerY, highScoreText, highScoreColor)
 	}
 
 	// Restart instruction
-	restartText := "Press 'R' to restart or 'Q' to quit"
+	restartText := "Press 'R' to restart, Enter for a summary, or 'Q' to quit"
 	restartX := centerX - len(restartText)/2
 	if restartX >= 0 && restartX+len(restartText) < r.width {
 		r.DrawString(restartX, centerY+2, restartText)
 	}
 }
 
+// DrawRunSummary renders the score breakdown and run statistics after a game over
+func (r *Renderer) DrawRunSummary(breakdown map[string]int, distance float64, obstacles int, duration time.Duration) {
+	r.Clear()
+
+	centerX := r.width / 2
+	centerY := r.height / 2
+
+	lines := []string{
+		"RUN SUMMARY",
+		"",
+		fmt.Sprintf("Time:      %6d  (%v)", breakdown["time"], duration.Truncate(time.Second)),
+		fmt.Sprintf("Obstacles: %6d  (%d passed)", breakdown["obstacles"], obstacles),
+		fmt.Sprintf("Distance:  %6d  (%.1f units)", breakdown["distance"], distance),
+		fmt.Sprintf("Total:     %6d", breakdown["total"]),
+		"",
+	}
+	// Leave rows for the breakdown bar and its legend
+	barRow := len(lines)
+	lines = append(lines, "", "", "", "Press any key to return to the menu")
+
+	// Left-align the statistics in a block centered on the screen
+	blockWidth := 0
+	for _, line := range lines {
+		if len(line) > blockWidth {
+			blockWidth = len(line)
+		}
+	}
+	x := centerX - blockWidth/2
+	if x < 0 {
+		x = 0
+	}
+	startY := centerY - len(lines)/2
+	for i, line := range lines {
+		y := startY + i
+		if y < 0 || y >= r.height {
+			continue
+		}
+		if i == 0 {
+			r.DrawStringWithColor(centerX-len(line)/2, y, line, "bold")
+			continue
+		}
+		r.DrawString(x, y, line)
+	}
+
+	r.DrawScoreBreakdownBar(startY+barRow, breakdown)
+}
+
+// DrawScoreBreakdownBar renders a stacked bar showing how much of the score came
+// from time, obstacles, and distance on row y, with a legend beneath it
+func (r *Renderer) DrawScoreBreakdownBar(y int, breakdown map[string]int) {
+	width := scoreBarWidth
+	if width > r.width-2 {
+		width = r.width - 2
+	}
+	if width <= 0 || y < 0 || y >= r.height {
+		return
+	}
+
+	x := (r.width - width - 2) / 2
+	r.DrawAt(x, y, '[')
+	r.DrawAt(x+width+1, y, ']')
+
+	widths := ScoreBarWidths(breakdown, width)
+	pos := x + 1
+	for i, source := range scoreBarSources {
+		for j := 0; j < widths[i]; j++ {
+			r.DrawAtWithColor(pos, y, source.fill, source.color)
+			pos++
+		}
+	}
+
+	if pos == x+1 {
+		// Nothing scored yet, so there are no proportions to show
+		for ; pos <= x+width; pos++ {
+			r.DrawAt(pos, y, '-')
+		}
+		r.DrawCenteredText(y+1, "No points scored")
+		return
+	}
+
+	legend := make([]string, len(scoreBarSources))
+	for i, source := range scoreBarSources {
+		legend[i] = fmt.Sprintf("%c %s", source.fill, source.key)
+	}
+	r.DrawCenteredText(y+1, strings.Join(legend, "  "))
+}
+
+// ScoreBarWidths splits width cells between the score sources in proportion to
+// their points, in drawing order. Cells left over from rounding go to the sources
+// with the largest remainders, so the widths always add up to width unless
+// nothing was scored, in which case they are all zero.
+func ScoreBarWidths(breakdown map[string]int, width int) []int {
+	widths := make([]int, len(scoreBarSources))
+	points := make([]int, len(scoreBarSources))
+	total := 0
+	for i, source := range scoreBarSources {
+		if p := breakdown[source.key]; p > 0 {
+			points[i] = p
+			total += p
+		}
+	}
+	if total == 0 || width <= 0 {
+		return widths
+	}
+
+	used := 0
+	remainders := make([]int, len(points))
+	for i, p := range points {
+		widths[i] = p * width / total
+		remainders[i] = p * width % total
+		used += widths[i]
+	}
+	for ; used < width; used++ {
+		largest := 0
+		for i := range remainders {
+			if remainders[i] > remainders[largest] {
+				largest = i
+			}
+		}
+		widths[largest]++
+		remainders[largest] = -1
+	}
+	return widths
+}
+
+// DrawQuitConfirm draws a quit prompt box over the middle of the current screen
+func (r *Renderer) DrawQuitConfirm() {
+	prompt := " Quit? (y/n) "
+	x := r.width/2 - len(prompt)/2
+	y := r.height / 2
+
+	// Blank the rows around the prompt so it stands out from what is behind it
+	blank := strings.Repeat(" ", len(prompt))
+	r.DrawString(x, y-1, blank)
+	r.DrawStringWithColor(x, y, prompt, "bold")
+	r.DrawString(x, y+1, blank)
+}
+
+// tutorialLines explain the basics to first-time players
+var tutorialLines = []string{
+	"HOW TO PLAY",
+	"",
+	"SPACE or UP  Jump over cacti and low birds",
+	"Stay down    High birds fly over your head",
+	"ESC          Back to the menu",
+	"Q            Quit",
+	"",
+	"Press any key to continue",
+}
+
+// DrawTutorial draws the first-launch tutorial in a box over the middle of the current screen
+func (r *Renderer) DrawTutorial() {
+	blockWidth := 0
+	for _, line := range tutorialLines {
+		if len(line) > blockWidth {
+			blockWidth = len(line)
+		}
+	}
+	blockWidth += 2 // A space of margin on each side
+
+	x := r.width/2 - blockWidth/2
+	if x < 0 {
+		x = 0
+	}
+	startY := r.height/2 - len(tutorialLines)/2
+
+	// Blank the rows around the text so it stands out from what is behind it
+	blank := strings.Repeat(" ", blockWidth)
+	r.DrawString(x, startY-1, blank)
+	for i, line := range tutorialLines {
+		r.DrawString(x, startY+i, blank)
+		if i == 0 {
+			r.DrawStringWithColor(r.width/2-len(line)/2, startY, line, "bold")
+			continue
+		}
+		r.DrawString(x+1, startY+i, line)
+	}
+	r.DrawString(x, startY+len(tutorialLines), blank)
+}
+
+// DrawAttractBanner labels the attract mode demo and invites the player to start
+func (r *Renderer) DrawAttractBanner() {
+	r.DrawCenteredText(2, "DEMO")
+	r.DrawCenteredText(3, "Press any key to play")
+}
+
+// DrawResizeMessage clears the screen and asks the player to enlarge the terminal
+func (r *Renderer) DrawResizeMessage(minWidth, minHeight int) {
+	r.Clear()
+
+	lines := []string{
+		fmt.Sprintf("Please resize your terminal to at least %dx%d", minWidth, minHeight),
+		fmt.Sprintf("(currently %dx%d)", r.width, r.height),
+	}
+	for i, line := range lines {
+		// Center when there's room, otherwise start at the left edge and let it clip
+		x := (r.width - len(line)) / 2
+		if x < 0 {
+			x = 0
+		}
+		r.DrawString(x, r.height/2+i, line)
+	}
+}
+
+// DrawSplashScreen renders the title with a prompt to press any key
+func (r *Renderer) DrawSplashScreen() {
+	// Clear the screen first
+	r.Clear()
+
+	centerX := r.width / 2
+	centerY := r.height / 2
+
+	r.drawTitle(centerX, centerY)
+
+	promptText := "Press any key to begin"
+	promptX := centerX - len(promptText)/2
+	promptY := centerY + 4
+	if promptX >= 0 && promptY < r.height && promptX+len(promptText) < r.width {
+		r.DrawString(promptX, promptY, promptText)
+	}
+}
+
 // DrawStartScreen renders the start/menu screen with instructions
 func (r *Renderer) DrawStartScreen() {
 	// Clear the screen first
@@ -185,6 +842,88 @@ func (r *Renderer) DrawStartScreen() {
 	centerX := r.width / 2
 	centerY := r.height / 2
 
+	r.drawTitle(centerX, centerY)
+
+	// Instructions
+	instructions := []string{
+		"SPACE/UP: Jump | Q: Quit",
+		"",
+		"Press SPACE to start",
+	}
+
+	instructionStartY := centerY + 4
+	for i, instruction := range instructions {
+		if instruction == "" {
+			continue // Skip empty lines
+		}
+		instrX := centerX - len(instruction)/2
+		instrY := instructionStartY + i
+		if instrX >= 0 && instrY < r.height && instrX+len(instruction) < r.width {
+			r.DrawString(instrX, instrY, instruction)
+		}
+	}
+}
+
+// DrawMenu renders the title screen with a navigable menu, highlighting the selected item
+func (r *Renderer) DrawMenu(menu *Menu) {
+	// Clear the screen first
+	r.Clear()
+
+	// Calculate center positions
+	centerX := r.width / 2
+	centerY := r.height / 2
+
+	r.drawTitle(centerX, centerY)
+	r.drawMenuItems(menu, centerX, centerY+4, "UP/DOWN: Select | ENTER: Confirm")
+}
+
+// DrawSettingsScreen renders the settings screen with one menu item per setting
+func (r *Renderer) DrawSettingsScreen(menu *Menu) {
+	// Clear the screen first
+	r.Clear()
+
+	centerX := r.width / 2
+	itemStartY := r.height/2 - len(menu.Items())/2
+
+	titleText := "SETTINGS"
+	titleX := centerX - len(titleText)/2
+	titleY := itemStartY - 2
+	if titleX >= 0 && titleY >= 0 && titleX+len(titleText) < r.width {
+		r.DrawStringWithColor(titleX, titleY, titleText, "bold")
+	}
+
+	r.drawMenuItems(menu, centerX, itemStartY, "UP/DOWN: Select | ENTER: Change")
+}
+
+// drawMenuItems draws menu items centered from startY, followed by a hint line
+func (r *Renderer) drawMenuItems(menu *Menu, centerX, startY int, hintText string) {
+	// Menu items, with the selection marked and bold
+	itemStartY := startY
+	for i, item := range menu.Items() {
+		itemText := "  " + item + "  "
+		color := "default"
+		if i == menu.SelectedIndex() {
+			itemText = "> " + item + " <"
+			color = "bold"
+		}
+
+		itemX := centerX - len(itemText)/2
+		itemY := itemStartY + i
+		if itemX >= 0 && itemY < r.height && itemX+len(itemText) < r.width {
+			r.DrawStringWithColor(itemX, itemY, itemText, color)
+		}
+	}
+
+	// Navigation hint
+	hintX := centerX - len(hintText)/2
+	hintY := itemStartY + len(menu.Items()) + 1
+	if hintX >= 0 && hintY < r.height && hintX+len(hintText) < r.width {
+		r.DrawString(hintX, hintY, hintText)
+	}
+}
+
+// drawTitle renders the game title and menu dinosaur above the screen center
+func (r *Renderer) drawTitle(centerX, centerY int) {
 	// Simple game title that fits in most terminals
 	titleText := "CLI DINO GAME"
 	titleX := centerX - len(titleText)/2
@@ -210,31 +949,15 @@ func (r *Renderer) DrawStartScreen() {
 			}
 		}
 	}
-
-	// Instructions
-	instructions := []string{
-		"SPACE/UP: Jump | Q: Quit",
-		"",
-		"Press SPACE to start",
-	}
-
-	instructionStartY := centerY + 4
-	for i, instruction := range instructions {
-		if instruction == "" {
-			continue // Skip empty lines
-		}
-		instrX := centerX - len(instruction)/2
-		instrY := instructionStartY + i
-		if instrX >= 0 && instrY < r.height && instrX+len(instruction) < r.width {
-			r.DrawString(instrX, instrY, instruction)
-		}
-	}
 }
 
 // DrawControlInstructions renders control instructions during gameplay
 func (r *Renderer) DrawControlInstructions() {
 	// Draw controls in bottom-left corner
 	controlText := "SPACE/UP: Jump | Q: Quit"
+	if r.isCompactHUD() {
+		controlText = "SPC:Jump Q:Quit"
+	}
 	if len(controlText) < r.width {
 		r.DrawString(1, r.height-1, controlText)
 	}
@@ -254,6 +977,11 @@ func (r *Renderer) DrawCenteredText(y int, text string) {
 
 // DrawBorder draws a border around the screen
 func (r *Renderer) DrawBorder() {
+	if r.highContrast {
+		r.drawSolidBorder()
+		return
+	}
+
 	// Top and bottom borders
 	for x := 0; x < r.width; x++ {
 		r.DrawAt(x, 0, '─')
@@ -272,3 +1000,15 @@ func (r *Renderer) DrawBorder() {
 	r.DrawAt(0, r.height-1, '└')
 	r.DrawAt(r.width-1, r.height-1, '┘')
 }
+
+// drawSolidBorder draws a border of full blocks for high contrast mode
+func (r *Renderer) drawSolidBorder() {
+	for x := 0; x < r.width; x++ {
+		r.DrawAt(x, 0, '█')
+		r.DrawAt(x, r.height-1, '█')
+	}
+	for y := 0; y < r.height; y++ {
+		r.DrawAt(0, y, '█')
+		r.DrawAt(r.width-1, y, '█')
+	}
+}
@@ -1,7 +1,12 @@
 package render
 
 import (
+	"fmt"
+	"strings"
 	"testing"
+	"time"
+
+	"github.com/nsf/termbox-go"
 )
 
 func TestRendererGetSize(t *testing.T) {
@@ -65,9 +70,12 @@ func TestUIRenderingEdgeCases(t *testing.T) {
 
 	// These should not panic even with small screen
 	renderer.DrawScore(999999, 888888) // Very long numbers
-	renderer.DrawGameOverScreen(12345, 54321, true)
+	renderer.DrawGameOverScreen(12345, 54321, true, 0)
 	renderer.DrawStartScreen()
 	renderer.DrawControlInstructions()
+	renderer.DrawTimeRemaining(90 * time.Second)
+	renderer.DrawSeed(1234567890123456789)
+	renderer.DrawDebugInfo([]string{"line 1", "line 2", "line 3", "line 4", "line 5"})
 
 	// Test drawing outside bounds
 	renderer.DrawCenteredText(-1, "Invalid Y")
@@ -121,3 +129,489 @@ func TestRendererDrawBox(t *testing.T) {
 	renderer.DrawBox(10, 10, 0, 3, '#') // Zero width
 	renderer.DrawBox(10, 10, 5, 0, '#') // Zero height
 }
+
+func TestDrawGameOverScreenPulsesNewHighScore(t *testing.T) {
+	backend := NewBufferBackend(80, 24)
+	renderer := NewRendererWithBackend(backend)
+	centerY := 24 / 2
+
+	attrAt := func(frame int) termbox.Attribute {
+		renderer.DrawGameOverScreen(2500, 2000, true, frame)
+		line := backend.Line(centerY)
+		x := strings.Index(line, "NEW HIGH SCORE!")
+		if x < 0 {
+			t.Fatalf("Expected new high score text on frame %d, got %q", frame, line)
+		}
+		return backend.Cell(x, centerY).Fg
+	}
+
+	first := attrAt(0)
+	second := attrAt(highScorePulseFrames)
+	if first == second {
+		t.Error("Expected alternating pulse phases to render differently")
+	}
+	if attrAt(2*highScorePulseFrames) != first {
+		t.Error("Expected the pulse to repeat")
+	}
+
+	// A regular high score line doesn't pulse
+	regularAttrAt := func(frame int) termbox.Attribute {
+		renderer.DrawGameOverScreen(100, 2000, false, frame)
+		line := backend.Line(centerY)
+		x := strings.Index(line, "High Score")
+		if x < 0 {
+			t.Fatalf("Expected the high score line on frame %d, got %q", frame, line)
+		}
+		return backend.Cell(x, centerY).Fg
+	}
+	if regularAttrAt(0) != regularAttrAt(highScorePulseFrames) {
+		t.Error("Expected a regular high score line not to pulse")
+	}
+}
+
+func TestDrawResizeMessage(t *testing.T) {
+	backend := NewBufferBackend(30, 8)
+	renderer := NewRendererWithBackend(backend)
+
+	renderer.DrawResizeMessage(40, 10)
+
+	line := backend.Line(4)
+	if !strings.HasPrefix(line, "Please resize your terminal") {
+		t.Errorf("Expected the resize message clipped from the left edge, got %q", line)
+	}
+	if !strings.Contains(backend.Line(5), "(currently 30x8)") {
+		t.Errorf("Expected the current size, got %q", backend.Line(5))
+	}
+}
+
+func TestRendererDrawObstacleWarning(t *testing.T) {
+	tests := []struct {
+		name        string
+		timeToReach time.Duration
+		threshold   time.Duration
+		expectShown bool
+	}{
+		{"within threshold", 300 * time.Millisecond, 500 * time.Millisecond, true},
+		{"at threshold", 500 * time.Millisecond, 500 * time.Millisecond, true},
+		{"beyond threshold", 800 * time.Millisecond, 500 * time.Millisecond, false},
+		{"disabled", 0, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			backend := NewBufferBackend(40, 10)
+			renderer := NewRendererWithBackend(backend)
+
+			renderer.DrawObstacleWarning(12, 3, tt.timeToReach, tt.threshold)
+
+			shown := backend.Cell(12, 3).Ch == '!'
+			if shown != tt.expectShown {
+				t.Errorf("Expected warning shown %v, got %v (line %q)", tt.expectShown, shown, backend.Line(3))
+			}
+		})
+	}
+}
+
+func TestRendererDrawRunSummary(t *testing.T) {
+	backend := NewBufferBackend(80, 24)
+	renderer := NewRendererWithBackend(backend)
+
+	breakdown := map[string]int{"time": 420, "obstacles": 700, "distance": 315, "total": 1435}
+	renderer.DrawRunSummary(breakdown, 315.4, 7, 42*time.Second+300*time.Millisecond)
+
+	var screen strings.Builder
+	for y := 0; y < 24; y++ {
+		screen.WriteString(backend.Line(y))
+		screen.WriteString("\n")
+	}
+	text := screen.String()
+
+	for _, want := range []string{"RUN SUMMARY", "420", "(42s)", "700", "(7 passed)", "315", "(315.4 units)", "1435"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("Expected run summary to contain %q, got:\n%s", want, text)
+		}
+	}
+}
+
+func TestScoreBarWidths(t *testing.T) {
+	tests := []struct {
+		name      string
+		breakdown map[string]int
+		width     int
+		expected  []int
+	}{
+		{"even split", map[string]int{"time": 100, "obstacles": 100, "distance": 100}, 30, []int{10, 10, 10}},
+		{"proportional", map[string]int{"time": 300, "obstacles": 100, "distance": 0}, 40, []int{30, 10, 0}},
+		{"rounding fills the width", map[string]int{"time": 1, "obstacles": 1, "distance": 1}, 10, []int{4, 3, 3}},
+		{"largest remainder wins", map[string]int{"time": 10, "obstacles": 70, "distance": 20}, 4, []int{0, 3, 1}},
+		{"single source", map[string]int{"distance": 5}, 12, []int{0, 0, 12}},
+		{"total is ignored", map[string]int{"time": 50, "total": 1000}, 8, []int{8, 0, 0}},
+		{"all zero", map[string]int{"time": 0, "obstacles": 0, "distance": 0, "total": 0}, 40, []int{0, 0, 0}},
+		{"negative ignored", map[string]int{"time": -20, "obstacles": 10}, 10, []int{0, 10, 0}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := ScoreBarWidths(tt.breakdown, tt.width)
+			for i := range tt.expected {
+				if result[i] != tt.expected[i] {
+					t.Errorf("ScoreBarWidths(%v, %d) = %v, want %v", tt.breakdown, tt.width, result, tt.expected)
+					break
+				}
+			}
+		})
+	}
+}
+
+func TestRendererDrawScoreBreakdownBar(t *testing.T) {
+	backend := NewBufferBackend(80, 24)
+	renderer := NewRendererWithBackend(backend)
+
+	breakdown := map[string]int{"time": 200, "obstacles": 100, "distance": 100, "total": 400}
+	renderer.DrawRunSummary(breakdown, 100, 1, 20*time.Second)
+
+	// The bar follows the totals after a blank row
+	barY := -1
+	for y := 0; y < 24; y++ {
+		if strings.Contains(backend.Line(y), "Total") {
+			barY = y + 2
+		}
+	}
+	bar := strings.TrimSpace(backend.Line(barY))
+	expected := "[" + strings.Repeat("=", 20) + strings.Repeat("#", 10) + strings.Repeat("~", 10) + "]"
+	if bar != expected {
+		t.Errorf("Expected bar %q, got %q", expected, bar)
+	}
+	if legend := backend.Line(barY + 1); !strings.Contains(legend, "= time") || !strings.Contains(legend, "~ distance") {
+		t.Errorf("Expected a legend under the bar, got %q", legend)
+	}
+
+	// The bar sits between the totals and the closing hint
+	if !strings.Contains(backend.Line(barY+3), "Press any key") {
+		t.Errorf("Expected the bar between the total and the hint")
+	}
+
+	// Nothing scored draws an empty bar
+	renderer.DrawRunSummary(map[string]int{}, 0, 0, 0)
+	if bar := strings.TrimSpace(backend.Line(barY)); bar != "["+strings.Repeat("-", scoreBarWidth)+"]" {
+		t.Errorf("Expected an empty bar, got %q", bar)
+	}
+	if legend := backend.Line(barY + 1); !strings.Contains(legend, "No points scored") {
+		t.Errorf("Expected a no points message, got %q", legend)
+	}
+
+	// Narrow screens shrink the bar to fit
+	narrow := NewBufferBackend(20, 24)
+	NewRendererWithBackend(narrow).DrawScoreBreakdownBar(barY, breakdown)
+	if bar := narrow.Line(barY); len([]rune(bar)) != 20 || bar[0] != '[' || bar[19] != ']' {
+		t.Errorf("Expected the bar to span a narrow screen, got %q", bar)
+	}
+}
+
+func TestRendererDrawQuitConfirm(t *testing.T) {
+	backend := NewBufferBackend(40, 10)
+	renderer := NewRendererWithBackend(backend)
+
+	renderer.DrawQuitConfirm()
+
+	if !strings.Contains(backend.Line(5), "Quit? (y/n)") {
+		t.Errorf("Expected the quit prompt in the middle row, got %q", backend.Line(5))
+	}
+}
+
+func TestRendererDrawHitbox(t *testing.T) {
+	backend := NewBufferBackend(40, 10)
+	renderer := NewRendererWithBackend(backend)
+	renderer.DrawString(5, 3, "######")
+
+	// A 6x4 box at (5, 2) covering cells 5-10 and rows 2-5
+	renderer.DrawHitbox(5, 2, 6, 4)
+
+	cells := []struct {
+		x, y int
+		ch   rune
+	}{
+		{5, 2, '┌'}, {10, 2, '┐'}, {5, 5, '└'}, {10, 5, '┘'},
+		{7, 2, '─'}, {7, 5, '─'},
+		{5, 3, '│'}, {10, 4, '│'},
+		{7, 3, '#'}, // The inside is left alone
+	}
+	for _, c := range cells {
+		if got := backend.Cell(c.x, c.y).Ch; got != c.ch {
+			t.Errorf("Expected %q at (%d, %d), got %q", c.ch, c.x, c.y, got)
+		}
+	}
+
+	// Nothing is drawn outside the box
+	if got := backend.Cell(11, 2).Ch; got != ' ' && got != 0 {
+		t.Errorf("Expected nothing right of the box, got %q", got)
+	}
+
+	// A one-row box is a plain line
+	renderer.DrawHitbox(20, 8, 3, 1)
+	for x := 20; x < 23; x++ {
+		if got := backend.Cell(x, 8).Ch; got != '─' {
+			t.Errorf("Expected a line for a one-row box at x=%d, got %q", x, got)
+		}
+	}
+}
+
+func TestProgressBar(t *testing.T) {
+	tests := []struct {
+		progress float64
+		expected string
+	}{
+		{0, "[----------]"},
+		{0.5, "[#####-----]"},
+		{1, "[##########]"},
+		{-1, "[----------]"},
+		{2, "[##########]"},
+	}
+
+	for _, tt := range tests {
+		if got := ProgressBar(tt.progress, 10); got != tt.expected {
+			t.Errorf("ProgressBar(%v): expected %q, got %q", tt.progress, tt.expected, got)
+		}
+	}
+}
+
+func TestRendererDrawAttractBanner(t *testing.T) {
+	backend := NewBufferBackend(80, 20)
+	renderer := NewRendererWithBackend(backend)
+
+	renderer.DrawAttractBanner()
+
+	if !strings.Contains(backend.Line(2), "DEMO") {
+		t.Errorf("Expected the demo label, got %q", backend.Line(2))
+	}
+	if !strings.Contains(backend.Line(3), "Press any key to play") {
+		t.Errorf("Expected the start prompt, got %q", backend.Line(3))
+	}
+}
+
+func TestRendererHighContrast(t *testing.T) {
+	backend := NewBufferBackend(20, 6)
+	renderer := NewRendererWithBackend(backend)
+	renderer.SetNoColor(false)
+	renderer.SetHighContrast(true)
+
+	// Every draw is bold, whatever its color
+	renderer.DrawString(1, 1, "a")
+	renderer.DrawAtWithColor(2, 1, 'b', "green")
+	renderer.DrawAtWithColor(3, 1, 'c', "ash")
+	for x := 1; x <= 3; x++ {
+		if backend.Cell(x, 1).Fg&termbox.AttrBold == 0 {
+			t.Errorf("Expected cell %d to be bold in high contrast mode", x)
+		}
+	}
+	if fg := backend.Cell(2, 1).Fg &^ termbox.AttrBold; fg != termbox.ColorGreen {
+		t.Errorf("Expected green to stay green, got %v", fg)
+	}
+	if backend.Cell(3, 1).Fg&termbox.AttrDim != 0 {
+		t.Error("Expected dim colors to be brightened in high contrast mode")
+	}
+
+	// Sprites are drawn from the high contrast set
+	for i, line := range HighContrastSprite([]string{"/\\", "||"}, '█') {
+		renderer.DrawString(5, 2+i, line)
+	}
+	if line := backend.Line(2); !strings.Contains(line, "██") {
+		t.Errorf("Expected a solid sprite, got %q", line)
+	}
+
+	// The border is solid
+	renderer.DrawBorder()
+	if ch := backend.Cell(0, 0).Ch; ch != '█' {
+		t.Errorf("Expected a solid border corner, got %q", ch)
+	}
+
+	// Switching back draws normally
+	renderer.SetHighContrast(false)
+	renderer.DrawString(1, 4, "a")
+	if backend.Cell(1, 4).Fg&termbox.AttrBold != 0 {
+		t.Error("Expected normal draws not to be bold")
+	}
+}
+
+func TestRendererSuspendResume(t *testing.T) {
+	backend := NewBufferBackend(10, 4)
+	renderer := NewRendererWithBackend(backend)
+	renderer.SetDiffRendering(true)
+
+	renderer.DrawString(0, 0, "dino")
+	renderer.Flush()
+
+	renderer.Suspend()
+	if !backend.IsClosed() || !renderer.IsSuspended() {
+		t.Fatal("Expected Suspend to give the terminal back")
+	}
+	renderer.Suspend() // A second suspend is harmless
+	if !renderer.IsSuspended() {
+		t.Error("Expected to stay suspended")
+	}
+
+	if err := renderer.Resume(); err != nil {
+		t.Fatalf("Unexpected error resuming: %v", err)
+	}
+	if backend.IsClosed() || renderer.IsSuspended() {
+		t.Fatal("Expected Resume to take the terminal back")
+	}
+
+	// The terminal was cleared while suspended, so the next frame is sent in full
+	backend.ResetCounters()
+	renderer.Clear()
+	renderer.DrawString(0, 0, "dino")
+	renderer.Flush()
+	if calls := backend.SetCellCalls(); calls != 10*4 {
+		t.Errorf("Expected a full redraw of 40 cells after resuming, got %d", calls)
+	}
+
+	// Resuming when not suspended does nothing
+	if err := renderer.Resume(); err != nil {
+		t.Errorf("Unexpected error resuming twice: %v", err)
+	}
+}
+
+func TestRendererCursorVisibility(t *testing.T) {
+	backend := NewBufferBackend(10, 4)
+	renderer := NewRendererWithBackend(backend)
+
+	if _, _, visible := backend.Cursor(); visible || renderer.IsCursorVisible() {
+		t.Fatal("Expected the cursor to be hidden once the renderer starts")
+	}
+
+	// Positioning a hidden cursor keeps it hidden
+	renderer.SetCursorPosition(3, 1)
+	if _, _, visible := backend.Cursor(); visible {
+		t.Error("Expected the cursor to stay hidden when only moved")
+	}
+
+	renderer.SetCursorVisible(true)
+	if x, y, visible := backend.Cursor(); !visible || x != 3 || y != 1 {
+		t.Errorf("Expected a visible cursor at (3, 1), got (%d, %d) visible=%v", x, y, visible)
+	}
+
+	renderer.SetCursorPosition(5, 2)
+	if x, y, _ := backend.Cursor(); x != 5 || y != 2 {
+		t.Errorf("Expected the cursor to follow to (5, 2), got (%d, %d)", x, y)
+	}
+	if x, y := renderer.GetCursorPosition(); x != 5 || y != 2 {
+		t.Errorf("Expected GetCursorPosition (5, 2), got (%d, %d)", x, y)
+	}
+
+	// A visible cursor comes back after a suspend
+	renderer.Suspend()
+	if err := renderer.Resume(); err != nil {
+		t.Fatalf("Unexpected error resuming: %v", err)
+	}
+	if x, y, visible := backend.Cursor(); !visible || x != 5 || y != 2 {
+		t.Errorf("Expected the cursor restored at (5, 2) after resuming, got (%d, %d) visible=%v", x, y, visible)
+	}
+
+	renderer.SetCursorVisible(false)
+	if _, _, visible := backend.Cursor(); visible || renderer.IsCursorVisible() {
+		t.Error("Expected the cursor to be hidden again")
+	}
+
+	// Closing gives the terminal its cursor back
+	renderer.Close()
+	if _, _, visible := backend.Cursor(); !visible {
+		t.Error("Expected the cursor to be restored on Close")
+	}
+}
+
+func TestRendererDrawGodMode(t *testing.T) {
+	backend := NewBufferBackend(40, 10)
+	renderer := NewRendererWithBackend(backend)
+
+	renderer.DrawGodMode()
+	renderer.Flush()
+
+	if line := strings.TrimSpace(backend.Line(0)); line != "GOD MODE" {
+		t.Errorf("Expected the god mode indicator on the top row, got %q", line)
+	}
+	if backend.Cell(16, 0).Ch != 'G' || backend.Cell(16, 0).Fg&termbox.AttrBold == 0 {
+		t.Error("Expected the indicator centered and bold")
+	}
+}
+
+func TestRendererDrawTutorial(t *testing.T) {
+	backend := NewBufferBackend(80, 24)
+	renderer := NewRendererWithBackend(backend)
+
+	renderer.DrawSplashScreen()
+	renderer.DrawTutorial()
+	renderer.Flush()
+
+	var screen strings.Builder
+	for y := 0; y < 24; y++ {
+		screen.WriteString(backend.Line(y))
+		screen.WriteString("\n")
+	}
+	text := screen.String()
+
+	for _, want := range []string{"HOW TO PLAY", "Jump", "High birds fly over", "Quit", "Press any key to continue"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("Expected the tutorial to contain %q, got:\n%s", want, text)
+		}
+	}
+	if strings.Contains(text, "Press any key to begin") {
+		t.Error("Expected the tutorial to cover the splash prompt")
+	}
+}
+
+func TestRendererCompactHUD(t *testing.T) {
+	tests := []struct {
+		width     int
+		wantScore string
+		wantTime  string
+	}{
+		{40, "Score: 12345", "Time: 42s"},
+		{20, "S:12345 H:99999", "T:42s"},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("width %d", tt.width), func(t *testing.T) {
+			backend := NewBufferBackend(tt.width, 10)
+			renderer := NewRendererWithBackend(backend)
+
+			renderer.DrawScore(12345, 99999)
+			renderer.DrawTimeRemaining(42 * time.Second)
+			renderer.DrawControlInstructions()
+			renderer.Flush()
+
+			if n := backend.OutOfBoundsWrites(); n != 0 {
+				t.Errorf("Expected no writes outside the screen, got %d", n)
+			}
+
+			var hud strings.Builder
+			for y := 0; y < 10; y++ {
+				hud.WriteString(backend.Line(y))
+				hud.WriteString("\n")
+			}
+			text := hud.String()
+			for _, want := range []string{tt.wantScore, "99999", tt.wantTime, "Jump"} {
+				if !strings.Contains(text, want) {
+					t.Errorf("Expected the HUD to contain %q, got:\n%s", want, text)
+				}
+			}
+
+			// The score and countdown never share a row's cells
+			if strings.Contains(backend.Line(0), "Time") && strings.Contains(backend.Line(0), "S:") {
+				t.Errorf("Expected the countdown off the compact score row, got %q", backend.Line(0))
+			}
+		})
+	}
+
+	// Too narrow for both scores: the current score wins
+	backend := NewBufferBackend(10, 5)
+	renderer := NewRendererWithBackend(backend)
+	renderer.DrawScore(12345, 99999)
+	renderer.Flush()
+	if line := strings.TrimSpace(backend.Line(0)); line != "S:12345" {
+		t.Errorf("Expected only the current score, got %q", line)
+	}
+	if n := backend.OutOfBoundsWrites(); n != 0 {
+		t.Errorf("Expected no writes outside the screen, got %d", n)
+	}
+}
@@ -0,0 +1,76 @@
+package render
+
+import "strings"
+
+// ScaleSprite magnifies a sprite by repeating each character scale times across
+// and each line scale times down. Scales below 2 return the sprite unchanged.
+func ScaleSprite(lines []string, scale int) []string {
+	if scale < 2 {
+		return lines
+	}
+
+	scaled := make([]string, 0, len(lines)*scale)
+	for _, line := range lines {
+		var b strings.Builder
+		for _, ch := range line {
+			for i := 0; i < scale; i++ {
+				b.WriteRune(ch)
+			}
+		}
+		for i := 0; i < scale; i++ {
+			scaled = append(scaled, b.String())
+		}
+	}
+	return scaled
+}
+
+// HighContrastSprite swaps a sprite for its solid silhouette, filling every
+// non-blank character with block so thin strokes are easier to see
+func HighContrastSprite(lines []string, block rune) []string {
+	solid := make([]string, len(lines))
+	for i, line := range lines {
+		var b strings.Builder
+		for _, ch := range line {
+			if ch == ' ' {
+				b.WriteRune(ch)
+			} else {
+				b.WriteRune(block)
+			}
+		}
+		solid[i] = b.String()
+	}
+	return solid
+}
+
+// DrawSprite draws a sprite's lines downward from (x, y) in the given color.
+// Spaces are transparent, so whatever is behind the sprite shows through its
+// gaps. Cells off screen are clipped, and empty lines leave their row untouched.
+func (r *Renderer) DrawSprite(x, y int, sprite []string, color string) {
+	r.drawSprite(x, y, sprite, color, false)
+}
+
+// DrawSpriteOpaque draws a sprite like DrawSprite, but its spaces blank out
+// whatever is behind them
+func (r *Renderer) DrawSpriteOpaque(x, y int, sprite []string, color string) {
+	r.drawSprite(x, y, sprite, color, true)
+}
+
+// drawSprite draws a sprite, skipping its spaces unless opaque
+func (r *Renderer) drawSprite(x, y int, sprite []string, color string, opaque bool) {
+	for i, line := range sprite {
+		row := y + i
+		if row < 0 || row >= r.height {
+			continue
+		}
+		col := x
+		for _, char := range line {
+			if col >= r.width {
+				break
+			}
+			if opaque || char != ' ' {
+				r.DrawAtWithColor(col, row, char, color)
+			}
+			col++
+		}
+	}
+}
@@ -0,0 +1,137 @@
+package render
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/nsf/termbox-go"
+)
+
+func TestHighContrastSprite(t *testing.T) {
+	sprite := []string{
+		" ╭╮",
+		"#. ",
+	}
+
+	result := HighContrastSprite(sprite, '█')
+	expected := []string{" ██", "██ "}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("HighContrastSprite(%v) = %v, want %v", sprite, result, expected)
+	}
+	if sprite[0] != " ╭╮" {
+		t.Error("Expected the original sprite to be left unchanged")
+	}
+}
+
+func TestScaleSprite(t *testing.T) {
+	sprite := []string{
+		"╭╮",
+		"#.",
+	}
+
+	tests := []struct {
+		name     string
+		scale    int
+		expected []string
+	}{
+		{"unscaled", 1, []string{"╭╮", "#."}},
+		{"zero scale", 0, []string{"╭╮", "#."}},
+		{"double", 2, []string{"╭╭╮╮", "╭╭╮╮", "##..", "##.."}},
+		{"triple", 3, []string{"╭╭╭╮╮╮", "╭╭╭╮╮╮", "╭╭╭╮╮╮", "###...", "###...", "###..."}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := ScaleSprite(sprite, tt.scale)
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("ScaleSprite(%v, %d) = %v, want %v", sprite, tt.scale, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestDrawSprite(t *testing.T) {
+	backend := NewBufferBackend(20, 10)
+	renderer := NewRendererWithBackend(backend)
+
+	// Something already on the row the empty line covers
+	renderer.DrawAt(3, 3, 'x')
+
+	renderer.DrawSprite(3, 2, []string{"ab", "", "cde"}, "red")
+
+	red, _ := ResolveColor("red")
+	expected := map[[2]int]rune{
+		{3, 2}: 'a', {4, 2}: 'b',
+		{3, 4}: 'c', {4, 4}: 'd', {5, 4}: 'e',
+	}
+	for pos, ch := range expected {
+		cell := backend.Cell(pos[0], pos[1])
+		if cell.Ch != ch || cell.Fg != red {
+			t.Errorf("Expected red %q at %v, got %q (fg %v)", ch, pos, cell.Ch, cell.Fg)
+		}
+	}
+	if cell := backend.Cell(3, 3); cell.Ch != 'x' || cell.Fg != termbox.ColorDefault {
+		t.Errorf("Expected the empty line to leave its row untouched, got %q", cell.Ch)
+	}
+	if line := backend.Line(5); line != "                    " {
+		t.Errorf("Expected nothing drawn past the sprite, got %q", line)
+	}
+}
+
+func TestDrawSpriteClipping(t *testing.T) {
+	backend := NewBufferBackend(10, 4)
+	renderer := NewRendererWithBackend(backend)
+
+	// Hanging off the top left and the bottom right corners
+	renderer.DrawSprite(-1, -1, []string{"abc", "def"}, "green")
+	renderer.DrawSprite(8, 3, []string{"ghi", "jkl"}, "green")
+
+	if backend.OutOfBoundsWrites() != 0 {
+		t.Errorf("Expected clipped cells not to be written, got %d out of bounds writes", backend.OutOfBoundsWrites())
+	}
+	if line := backend.Line(0); line != "ef        " {
+		t.Errorf("Expected the visible corner of the first sprite, got %q", line)
+	}
+	if line := backend.Line(3); line != "        gh" {
+		t.Errorf("Expected the visible corner of the second sprite, got %q", line)
+	}
+}
+
+func TestDrawSpriteTransparency(t *testing.T) {
+	tests := []struct {
+		name     string
+		opaque   bool
+		expected string
+	}{
+		{"transparent", false, "~a~b~~"},
+		{"opaque", true, " a b ~"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			backend := NewBufferBackend(6, 3)
+			renderer := NewRendererWithBackend(backend)
+
+			// Scenery behind the sprite
+			renderer.DrawString(0, 1, "~~~~~~")
+
+			sprite := []string{" a b ", "c   d"}
+			if tt.opaque {
+				renderer.DrawSpriteOpaque(0, 1, sprite, "bold")
+			} else {
+				renderer.DrawSprite(0, 1, sprite, "bold")
+			}
+
+			if line := backend.Line(1); line != tt.expected {
+				t.Errorf("Expected %q where the sprite covers the scenery, got %q", tt.expected, line)
+			}
+			// The scenery keeps its own color in the gaps
+			if !tt.opaque && backend.Cell(0, 1).Fg&termbox.AttrBold != 0 {
+				t.Error("Expected the scenery showing through a gap to keep its color")
+			}
+			if line := backend.Line(2); line != "c   d " {
+				t.Errorf("Expected the sprite's second line over an empty row, got %q", line)
+			}
+		})
+	}
+}
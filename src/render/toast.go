@@ -0,0 +1,84 @@
+package render
+
+import (
+	"cli-dino-game/src/clock"
+	"time"
+	"unicode/utf8"
+)
+
+// Toast layout
+const (
+	toastRow         = 2 // Row of the first toast, centered below the top HUD rows
+	maxVisibleToasts = 3 // Toasts stacked on screen at once; the rest wait their turn
+)
+
+// toast is a queued message. Its time starts when it first reaches the screen.
+type toast struct {
+	message  string
+	duration time.Duration
+	shownAt  time.Time
+}
+
+// SetClock sets the time source toasts expire by
+func (r *Renderer) SetClock(c clock.Clock) {
+	r.clock = c
+}
+
+// EnqueueToast queues a message to show for duration once there is room on screen
+func (r *Renderer) EnqueueToast(message string, duration time.Duration) {
+	r.toasts = append(r.toasts, toast{message: message, duration: duration})
+}
+
+// ClearToasts drops every queued and visible toast
+func (r *Renderer) ClearToasts() {
+	r.toasts = nil
+}
+
+// VisibleToasts returns the messages DrawToasts shows, oldest first
+func (r *Renderer) VisibleToasts() []string {
+	r.updateToasts()
+
+	var messages []string
+	for i := 0; i < len(r.toasts) && i < maxVisibleToasts; i++ {
+		messages = append(messages, r.toasts[i].message)
+	}
+	return messages
+}
+
+// DrawToasts draws the visible toasts stacked downwards, oldest on top
+func (r *Renderer) DrawToasts() {
+	for i, message := range r.VisibleToasts() {
+		r.DrawToast(toastRow+i, message)
+	}
+}
+
+// DrawToast renders a brief message centered on the given row
+func (r *Renderer) DrawToast(y int, message string) {
+	text := " " + message + " "
+	x := (r.width - utf8.RuneCountInString(text)) / 2
+	if x < 0 || y < 0 || y >= r.height {
+		return
+	}
+	r.DrawStringWithColor(x, y, text, "bold")
+}
+
+// updateToasts drops toasts whose time is up and starts the time of those
+// that just reached the screen
+func (r *Renderer) updateToasts() {
+	now := r.clock.Now()
+
+	kept := r.toasts[:0]
+	for _, t := range r.toasts {
+		if !t.shownAt.IsZero() && now.Sub(t.shownAt) >= t.duration {
+			continue
+		}
+		kept = append(kept, t)
+	}
+	r.toasts = kept
+
+	for i := 0; i < len(r.toasts) && i < maxVisibleToasts; i++ {
+		if r.toasts[i].shownAt.IsZero() {
+			r.toasts[i].shownAt = now
+		}
+	}
+}
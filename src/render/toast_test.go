@@ -0,0 +1,92 @@
+package render
+
+import (
+	"cli-dino-game/src/clock"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/nsf/termbox-go"
+)
+
+func newToastRenderer() (*Renderer, *BufferBackend, *clock.FakeClock) {
+	backend := NewBufferBackend(40, 10)
+	renderer := NewRendererWithBackend(backend)
+	fake := clock.NewFakeClock(time.Now())
+	renderer.SetClock(fake)
+	return renderer, backend, fake
+}
+
+func TestDrawToast(t *testing.T) {
+	renderer, backend, _ := newToastRenderer()
+
+	renderer.DrawToast(toastRow, "100m!")
+	renderer.Flush()
+
+	line := backend.Line(toastRow)
+	if strings.TrimSpace(line) != "100m!" {
+		t.Errorf("Expected the toast on row %d, got %q", toastRow, line)
+	}
+	if x := strings.Index(line, "100m!"); x != 17 {
+		t.Errorf("Expected the toast centered at column 17, got %d", x)
+	}
+	if backend.Cell(17, toastRow).Fg&termbox.AttrBold == 0 {
+		t.Error("Expected the toast to be bold")
+	}
+}
+
+func TestToastExpiryOrder(t *testing.T) {
+	renderer, _, fake := newToastRenderer()
+
+	renderer.EnqueueToast("first", 2*time.Second)
+	renderer.EnqueueToast("second", time.Second)
+	if got := renderer.VisibleToasts(); !reflect.DeepEqual(got, []string{"first", "second"}) {
+		t.Fatalf("Expected toasts oldest first, got %v", got)
+	}
+
+	// The shorter toast goes first even though it was queued later
+	fake.Advance(time.Second)
+	if got := renderer.VisibleToasts(); !reflect.DeepEqual(got, []string{"first"}) {
+		t.Errorf("Expected only the first toast left, got %v", got)
+	}
+
+	fake.Advance(time.Second)
+	if got := renderer.VisibleToasts(); len(got) != 0 {
+		t.Errorf("Expected every toast to expire, got %v", got)
+	}
+}
+
+func TestToastMaxVisible(t *testing.T) {
+	renderer, backend, fake := newToastRenderer()
+
+	for _, message := range []string{"a", "b", "c", "d"} {
+		renderer.EnqueueToast(message, time.Second)
+	}
+	if got := renderer.VisibleToasts(); !reflect.DeepEqual(got, []string{"a", "b", "c"}) {
+		t.Fatalf("Expected %d toasts visible, got %v", maxVisibleToasts, got)
+	}
+
+	renderer.DrawToasts()
+	renderer.Flush()
+	for i, message := range []string{"a", "b", "c"} {
+		if line := strings.TrimSpace(backend.Line(toastRow + i)); line != message {
+			t.Errorf("Expected %q stacked on row %d, got %q", message, toastRow+i, line)
+		}
+	}
+
+	// A waiting toast's time only starts once it reaches the screen
+	fake.Advance(time.Second)
+	if got := renderer.VisibleToasts(); !reflect.DeepEqual(got, []string{"d"}) {
+		t.Errorf("Expected the waiting toast to show next, got %v", got)
+	}
+	fake.Advance(500 * time.Millisecond)
+	if got := renderer.VisibleToasts(); !reflect.DeepEqual(got, []string{"d"}) {
+		t.Errorf("Expected the waiting toast to get its full time, got %v", got)
+	}
+
+	renderer.ClearToasts()
+	if got := renderer.VisibleToasts(); len(got) != 0 {
+		t.Errorf("Expected no toasts after clearing, got %v", got)
+	}
+}
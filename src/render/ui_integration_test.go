@@ -31,11 +31,11 @@ func TestUIIntegration(t *testing.T) {
 		renderer.Clear()
 
 		// Test regular game over - should not panic
-		renderer.DrawGameOverScreen(1500, 2000, false)
+		renderer.DrawGameOverScreen(1500, 2000, false, 0)
 
 		// Test new high score - should not panic
 		renderer.Clear()
-		renderer.DrawGameOverScreen(2500, 2000, true)
+		renderer.DrawGameOverScreen(2500, 2000, true, 0)
 		// Test passes if no panic occurs
 	})
 
@@ -53,7 +53,7 @@ func TestUIIntegration(t *testing.T) {
 
 		// Game over screen
 		renderer.Clear()
-		renderer.DrawGameOverScreen(100, 500, false)
+		renderer.DrawGameOverScreen(100, 500, false, 0)
 		// Test passes if no panic occurs
 	})
 }
@@ -86,7 +86,7 @@ func TestUIResponsiveness(t *testing.T) {
 			renderer.DrawControlInstructions()
 			renderer.Clear()
 
-			renderer.DrawGameOverScreen(12345, 67890, true)
+			renderer.DrawGameOverScreen(12345, 67890, true, 0)
 			renderer.Clear()
 
 			// Test centered text with various sizes
@@ -127,7 +127,7 @@ func TestUIContentAccuracy(t *testing.T) {
 
 			// Test game over screen with various inputs
 			renderer.Clear()
-			renderer.DrawGameOverScreen(tc.currentScore, tc.highScore, tc.isNewHigh)
+			renderer.DrawGameOverScreen(tc.currentScore, tc.highScore, tc.isNewHigh, 0)
 			// Test passes if no panic occurs
 		})
 	}
@@ -1,10 +1,12 @@
 package score
 
 import (
+	"cli-dino-game/src/clock"
 	"encoding/json"
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"time"
 )
 
@@ -20,11 +22,20 @@ type Score struct {
 	TimeMultiplier     int     `json:"time_multiplier"`     // Points per second
 	ObstacleBonus      int     `json:"obstacle_bonus"`      // Bonus points per obstacle
 	DistanceMultiplier float64 `json:"distance_multiplier"` // Points per distance unit
+	DistancePerSecond  float64 `json:"distance_per_second"` // Distance units covered per second
 
 	// Internal tracking
+	clock           clock.Clock // Time source for durations and time-based points
 	obstaclesPassed int
 	gameStartTime   time.Time
+	gameEndTime     time.Time // Set when the score is finalized so the duration stops growing
 	lastScoreTime   time.Time
+	saving          bool // Whether finished runs can set and save a new high score
+
+	// Distance milestones, in increasing order, and the next one to reach
+	milestones    []float64
+	nextMilestone int
+	onMilestone   func(milestone float64)
 }
 
 // ScoreData represents the persistent score data
@@ -32,20 +43,31 @@ type ScoreData struct {
 	HighScore int `json:"high_score"`
 }
 
+// DefaultDistancePerSecond is the distance covered per second until a rate is set
+const DefaultDistancePerSecond = 10.0
+
+// DefaultMilestones are the distances announced during a run
+var DefaultMilestones = []float64{100, 500, 1000}
+
 // NewScore creates a new Score instance with default configuration
 func NewScore() *Score {
+	now := clock.Real.Now()
 	return &Score{
 		Current:            0,
 		High:               0,
 		Distance:           0,
-		StartTime:          time.Now(),
-		LastUpdate:         time.Now(),
+		StartTime:          now,
+		LastUpdate:         now,
 		TimeMultiplier:     10,  // 10 points per second
 		ObstacleBonus:      100, // 100 points per obstacle
 		DistanceMultiplier: 1.0, // 1 point per distance unit
+		DistancePerSecond:  DefaultDistancePerSecond,
+		clock:              clock.Real,
 		obstaclesPassed:    0,
-		gameStartTime:      time.Now(),
-		lastScoreTime:      time.Now(),
+		gameStartTime:      now,
+		lastScoreTime:      now,
+		saving:             true,
+		milestones:         append([]float64(nil), DefaultMilestones...),
 	}
 }
 
@@ -63,18 +85,45 @@ func (s *Score) Reset() {
 	s.Current = 0
 	s.Distance = 0
 	s.obstaclesPassed = 0
-	s.gameStartTime = time.Now()
-	s.lastScoreTime = time.Now()
-	s.StartTime = time.Now()
-	s.LastUpdate = time.Now()
+	s.gameStartTime = s.clock.Now()
+	s.gameEndTime = time.Time{}
+	s.lastScoreTime = s.clock.Now()
+	s.StartTime = s.clock.Now()
+	s.LastUpdate = s.clock.Now()
+	s.nextMilestone = 0
+}
+
+// SetClock sets the time source for durations and time-based points, restarting the game timers
+func (s *Score) SetClock(c clock.Clock) {
+	s.clock = c
+	now := c.Now()
+	s.gameStartTime = now
+	s.lastScoreTime = now
+	s.StartTime = now
+	s.LastUpdate = now
+}
+
+// SetDistanceRate sets how many distance units are covered per second, normally
+// the speed the world scrolls at. Negative rates are treated as 0.
+func (s *Score) SetDistanceRate(rate float64) {
+	if rate < 0 {
+		rate = 0
+	}
+	s.DistancePerSecond = rate
+}
+
+// GetDistanceRate returns how many distance units are covered per second
+func (s *Score) GetDistanceRate() float64 {
+	return s.DistancePerSecond
 }
 
 // Update updates the score based on time elapsed
 func (s *Score) Update(deltaTime float64) {
-	now := time.Now()
+	now := s.clock.Now()
 
-	// Update distance (assuming constant movement)
-	s.Distance += deltaTime * 10.0 // Arbitrary distance units per second
+	// Distance follows how fast the world is moving
+	s.Distance += deltaTime * s.DistancePerSecond
+	s.checkMilestones()
 
 	// Calculate time-based score
 	timeSinceLastScore := now.Sub(s.lastScoreTime).Seconds()
@@ -93,11 +142,45 @@ func (s *Score) Update(deltaTime float64) {
 	s.LastUpdate = now
 }
 
+// SetMilestones sets the distances announced during a run. Milestones already
+// passed this run are not announced again.
+func (s *Score) SetMilestones(distances []float64) {
+	s.milestones = append([]float64(nil), distances...)
+	sort.Float64s(s.milestones)
+
+	s.nextMilestone = 0
+	for s.nextMilestone < len(s.milestones) && s.Distance >= s.milestones[s.nextMilestone] {
+		s.nextMilestone++
+	}
+}
+
+// GetMilestones returns the distances announced during a run
+func (s *Score) GetMilestones() []float64 {
+	return append([]float64(nil), s.milestones...)
+}
+
+// SetMilestoneCallback sets a function called with each milestone the first time
+// a run reaches it
+func (s *Score) SetMilestoneCallback(callback func(milestone float64)) {
+	s.onMilestone = callback
+}
+
+// checkMilestones announces every milestone the distance has reached since the last check
+func (s *Score) checkMilestones() {
+	for s.nextMilestone < len(s.milestones) && s.Distance >= s.milestones[s.nextMilestone] {
+		milestone := s.milestones[s.nextMilestone]
+		s.nextMilestone++
+		if s.onMilestone != nil {
+			s.onMilestone(milestone)
+		}
+	}
+}
+
 // AddObstacleBonus adds bonus points for successfully passing an obstacle
 func (s *Score) AddObstacleBonus() {
 	s.obstaclesPassed++
 	s.Current += s.ObstacleBonus
-	s.LastUpdate = time.Now()
+	s.LastUpdate = s.clock.Now()
 }
 
 // GetCurrent returns the current score
@@ -120,14 +203,30 @@ func (s *Score) GetObstaclesPassed() int {
 	return s.obstaclesPassed
 }
 
-// GetGameDuration returns how long the current game has been running
+// GetGameDuration returns how long the current game has been running, or how long it
+// lasted once the score has been finalized
 func (s *Score) GetGameDuration() time.Duration {
-	return time.Since(s.gameStartTime)
+	if !s.gameEndTime.IsZero() {
+		return s.gameEndTime.Sub(s.gameStartTime)
+	}
+	return s.clock.Now().Sub(s.gameStartTime)
 }
 
-// IsNewHighScore checks if the current score is a new high score
+// IsNewHighScore checks if the current score is a new high score. Runs that
+// aren't saved never set one.
 func (s *Score) IsNewHighScore() bool {
-	return s.Current > s.High
+	return s.saving && s.Current > s.High
+}
+
+// SetSaving turns high score saving on or off. Finished runs that aren't saved
+// leave the high score as it was.
+func (s *Score) SetSaving(enabled bool) {
+	s.saving = enabled
+}
+
+// IsSaving returns whether finished runs can set and save a new high score
+func (s *Score) IsSaving() bool {
+	return s.saving
 }
 
 // UpdateHighScore updates the high score if current score is higher
@@ -159,8 +258,8 @@ func (s *Score) String() string {
 		s.Current, s.High, s.Distance, s.obstaclesPassed, s.GetGameDuration().Truncate(time.Second))
 }
 
-// getScoreFilePath returns the path to the score file
-func getScoreFilePath() (string, error) {
+// GetDataDir returns the directory holding scores and other saved game files, creating it if needed
+func GetDataDir() (string, error) {
 	homeDir, err := os.UserHomeDir()
 	if err != nil {
 		return "", fmt.Errorf("failed to get user home directory: %w", err)
@@ -171,9 +270,29 @@ func getScoreFilePath() (string, error) {
 		return "", fmt.Errorf("failed to create score directory: %w", err)
 	}
 
+	return scoreDir, nil
+}
+
+// getScoreFilePath returns the path to the score file
+func getScoreFilePath() (string, error) {
+	scoreDir, err := GetDataDir()
+	if err != nil {
+		return "", err
+	}
+
 	return filepath.Join(scoreDir, "scores.json"), nil
 }
 
+// HasSavedHighScore reports whether a high score has ever been saved
+func HasSavedHighScore() bool {
+	filePath, err := getScoreFilePath()
+	if err != nil {
+		return false
+	}
+	_, err = os.Stat(filePath)
+	return err == nil
+}
+
 // LoadHighScore loads the high score from persistent storage
 func LoadHighScore() (int, error) {
 	filePath, err := getScoreFilePath()
@@ -239,6 +358,7 @@ func (s *Score) SaveHighScoreFrom() error {
 
 // FinalizeScore finalizes the score at game end, updating high score if necessary
 func (s *Score) FinalizeScore() (bool, error) {
+	s.gameEndTime = s.clock.Now()
 	isNewHigh := s.UpdateHighScore()
 	if isNewHigh {
 		if err := s.SaveHighScoreFrom(); err != nil {
@@ -1,8 +1,12 @@
 package score
 
 import (
+	"cli-dino-game/src/clock"
+	"math"
 	"os"
+	"reflect"
 	"testing"
+	"time"
 )
 
 func TestNewScore(t *testing.T) {
@@ -88,6 +92,96 @@ func TestScoreUpdate(t *testing.T) {
 	}
 }
 
+func TestScoreDistanceRate(t *testing.T) {
+	score := NewScore()
+	score.Reset()
+
+	if score.GetDistanceRate() != DefaultDistancePerSecond {
+		t.Errorf("Expected default rate %f, got %f", DefaultDistancePerSecond, score.GetDistanceRate())
+	}
+
+	tests := []struct {
+		rate     float64
+		seconds  float64
+		expected float64
+	}{
+		{18.0, 0.5, 9.0},
+		{25.0, 2.0, 50.0},
+		{0, 3.0, 0},
+		{-5.0, 1.0, 0}, // Negative rates are treated as standing still
+	}
+
+	for _, tt := range tests {
+		score.Reset()
+		score.SetDistanceRate(tt.rate)
+
+		// Split the time into frames; the total must not depend on the frame rate
+		for i := 0; i < 10; i++ {
+			score.Update(tt.seconds / 10)
+		}
+		if math.Abs(score.Distance-tt.expected) > 1e-9 {
+			t.Errorf("Rate %.1f for %.1fs: expected distance %.2f, got %.2f", tt.rate, tt.seconds, tt.expected, score.Distance)
+		}
+	}
+}
+
+func TestScoreMilestones(t *testing.T) {
+	score := NewScore()
+	score.Reset()
+	score.SetDistanceRate(100)
+
+	var fired []float64
+	score.SetMilestoneCallback(func(milestone float64) {
+		fired = append(fired, milestone)
+	})
+
+	// 100 units a second: one second reaches 100, six reach 500, ten reach 1000
+	for i := 0; i < 120; i++ {
+		score.Update(0.1)
+	}
+	expected := []float64{100, 500, 1000}
+	if !reflect.DeepEqual(fired, expected) {
+		t.Fatalf("Expected each milestone to fire once, got %v", fired)
+	}
+
+	// A new run announces them again
+	score.Reset()
+	fired = nil
+	score.Update(1.0)
+	if !reflect.DeepEqual(fired, []float64{100}) {
+		t.Errorf("Expected 100 to fire again after a reset, got %v", fired)
+	}
+}
+
+func TestScoreMilestonesCrossedInOneUpdate(t *testing.T) {
+	score := NewScore()
+	score.Reset()
+	score.SetMilestones([]float64{50, 10, 20})
+
+	var fired []float64
+	score.SetMilestoneCallback(func(milestone float64) {
+		fired = append(fired, milestone)
+	})
+
+	// A long frame crossing several milestones announces each in order
+	score.SetDistanceRate(30)
+	score.Update(1.0)
+	if !reflect.DeepEqual(fired, []float64{10, 20}) {
+		t.Errorf("Expected 10 and 20 to fire in order, got %v", fired)
+	}
+	if got := score.GetMilestones(); !reflect.DeepEqual(got, []float64{10, 20, 50}) {
+		t.Errorf("Expected sorted milestones, got %v", got)
+	}
+
+	// Milestones already passed are not announced when the list changes
+	fired = nil
+	score.SetMilestones([]float64{10, 25, 50})
+	score.Update(1.0)
+	if !reflect.DeepEqual(fired, []float64{50}) {
+		t.Errorf("Expected only 50 to fire, got %v", fired)
+	}
+}
+
 func TestAddObstacleBonus(t *testing.T) {
 	score := NewScore()
 	initialScore := score.Current
@@ -260,6 +354,82 @@ func TestFinalizeScore(t *testing.T) {
 	}
 }
 
+func TestFinalizeScoreWithoutSaving(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	score := NewScore()
+	score.SetSaving(false)
+	score.High = 100
+	score.Current = 200
+
+	if score.IsNewHighScore() {
+		t.Error("Expected a run that isn't saved not to be a new high score")
+	}
+	isNewHigh, err := score.FinalizeScore()
+	if err != nil {
+		t.Fatalf("Failed to finalize score: %v", err)
+	}
+	if isNewHigh || score.High != 100 {
+		t.Errorf("Expected the high score to stay 100, got %d (new high %v)", score.High, isNewHigh)
+	}
+	if saved, _ := LoadHighScore(); saved != 0 {
+		t.Errorf("Expected nothing saved, got %d", saved)
+	}
+
+	// Saving again records the next run
+	score.Reset()
+	score.SetSaving(true)
+	score.Current = 300
+	if isNewHigh, _ := score.FinalizeScore(); !isNewHigh || score.High != 300 {
+		t.Errorf("Expected a saved run to set the high score to 300, got %d", score.High)
+	}
+}
+
+func TestFinalizeScoreFreezesDuration(t *testing.T) {
+	score := NewScore()
+	fake := clock.NewFakeClock(time.Now())
+	score.SetClock(fake)
+	fake.Advance(2 * time.Second)
+
+	// Not a new high score, so nothing is written to disk
+	if _, err := score.FinalizeScore(); err != nil {
+		t.Fatalf("Failed to finalize score: %v", err)
+	}
+
+	fake.Advance(10 * time.Second)
+	if duration := score.GetGameDuration(); duration != 2*time.Second {
+		t.Errorf("Expected the game duration to stop at 2s once finalized, got %v", duration)
+	}
+
+	score.Reset()
+	if duration := score.GetGameDuration(); duration != 0 {
+		t.Errorf("Expected Reset to start timing a new game, got %v", duration)
+	}
+}
+
+func TestScoreTimePointsUseClock(t *testing.T) {
+	score := NewScoreWithConfig(10, 100, 0)
+	fake := clock.NewFakeClock(time.Now())
+	score.SetClock(fake)
+
+	// Less than a second earns nothing
+	fake.Advance(900 * time.Millisecond)
+	score.Update(0)
+	if score.GetCurrent() != 0 {
+		t.Errorf("Expected no time points before a full second, got %d", score.GetCurrent())
+	}
+
+	// Whole seconds are paid out at the time multiplier
+	fake.Advance(2100 * time.Millisecond)
+	score.Update(0)
+	if score.GetCurrent() != 30 {
+		t.Errorf("Expected 30 points after 3 seconds, got %d", score.GetCurrent())
+	}
+	if score.GetGameDuration() != 3*time.Second {
+		t.Errorf("Expected a 3s game duration, got %v", score.GetGameDuration())
+	}
+}
+
 func TestLoadHighScoreNonExistentFile(t *testing.T) {
 	// Create a temporary directory for testing
 	tempDir := t.TempDir()
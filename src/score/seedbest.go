@@ -0,0 +1,117 @@
+package score
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// getSeedBestFilePath returns the path to the file of best scores per seed
+func getSeedBestFilePath() (string, error) {
+	scoreDir, err := GetDataDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(scoreDir, "seed_bests.json"), nil
+}
+
+// loadSeedBests reads the best score for every seed played with a fixed seed
+func loadSeedBests() (map[int64]int, error) {
+	filePath, err := getSeedBestFilePath()
+	if err != nil {
+		return nil, err
+	}
+
+	bests := make(map[int64]int)
+	data, err := os.ReadFile(filePath)
+	if os.IsNotExist(err) {
+		return bests, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read seed bests file: %w", err)
+	}
+	if err := json.Unmarshal(data, &bests); err != nil {
+		return nil, fmt.Errorf("failed to parse seed bests file: %w", err)
+	}
+	return bests, nil
+}
+
+// LoadSeedBest returns the best score saved for runs with the given seed, or 0
+// if none has been saved
+func LoadSeedBest(seed int64) (int, error) {
+	bests, err := loadSeedBests()
+	if err != nil {
+		return 0, err
+	}
+	return bests[seed], nil
+}
+
+// SeedBest is the best score saved for one seed
+type SeedBest struct {
+	Seed  int64
+	Score int
+}
+
+// LoadSeedBests returns the best score saved for every seed, highest first
+func LoadSeedBests() ([]SeedBest, error) {
+	bests, err := loadSeedBests()
+	if err != nil {
+		return nil, err
+	}
+
+	ranked := make([]SeedBest, 0, len(bests))
+	for seed, score := range bests {
+		ranked = append(ranked, SeedBest{Seed: seed, Score: score})
+	}
+	sort.Slice(ranked, func(i, j int) bool {
+		if ranked[i].Score != ranked[j].Score {
+			return ranked[i].Score > ranked[j].Score
+		}
+		return ranked[i].Seed < ranked[j].Seed
+	})
+	return ranked, nil
+}
+
+// SaveSeedBest records score as the best for the given seed if it beats the
+// one already saved
+func SaveSeedBest(seed int64, score int) error {
+	bests, err := loadSeedBests()
+	if err != nil {
+		return err
+	}
+	if score <= bests[seed] {
+		return nil
+	}
+	bests[seed] = score
+
+	filePath, err := getSeedBestFilePath()
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(bests)
+	if err != nil {
+		return fmt.Errorf("failed to marshal seed bests: %w", err)
+	}
+	if err := os.WriteFile(filePath, data, 0644); err != nil {
+		return fmt.Errorf("failed to write seed bests file: %w", err)
+	}
+	return nil
+}
+
+// CompareSeedBest describes how a run's score compares to the best saved for its
+// seed before the run, so players sharing a seed can see where they stand
+func CompareSeedBest(seed int64, score, best int) string {
+	switch {
+	case best == 0:
+		return fmt.Sprintf("First score for seed %d", seed)
+	case score > best:
+		return fmt.Sprintf("New best for seed %d, beating %d by %d", seed, best, score-best)
+	case score == best:
+		return fmt.Sprintf("Matched the best for seed %d", seed)
+	default:
+		return fmt.Sprintf("%d short of the best for seed %d (%d)", best-score, seed, best)
+	}
+}
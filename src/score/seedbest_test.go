@@ -0,0 +1,113 @@
+package score
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestSeedBestPersistence(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	if best, err := LoadSeedBest(42); err != nil || best != 0 {
+		t.Errorf("Expected no best for an unplayed seed, got %d (%v)", best, err)
+	}
+
+	if err := SaveSeedBest(42, 1500); err != nil {
+		t.Fatalf("Failed to save seed best: %v", err)
+	}
+	if err := SaveSeedBest(7, 300); err != nil {
+		t.Fatalf("Failed to save seed best: %v", err)
+	}
+
+	// A lower score doesn't replace the best
+	if err := SaveSeedBest(42, 900); err != nil {
+		t.Fatalf("Failed to save seed best: %v", err)
+	}
+
+	tests := []struct {
+		seed     int64
+		expected int
+	}{
+		{42, 1500},
+		{7, 300},
+		{-3, 0},
+	}
+	for _, tt := range tests {
+		best, err := LoadSeedBest(tt.seed)
+		if err != nil {
+			t.Errorf("Seed %d: failed to load best: %v", tt.seed, err)
+		}
+		if best != tt.expected {
+			t.Errorf("Seed %d: expected best %d, got %d", tt.seed, tt.expected, best)
+		}
+	}
+
+	// Seed bests don't touch the overall high score
+	if high, _ := LoadHighScore(); high != 0 {
+		t.Errorf("Expected the high score untouched, got %d", high)
+	}
+}
+
+func TestLoadSeedBestsRanked(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	if bests, err := LoadSeedBests(); err != nil || len(bests) != 0 {
+		t.Errorf("Expected no seed bests before any run, got %v (%v)", bests, err)
+	}
+
+	for seed, score := range map[int64]int{7: 300, 42: 1500, 3: 300} {
+		if err := SaveSeedBest(seed, score); err != nil {
+			t.Fatalf("Failed to save seed best: %v", err)
+		}
+	}
+
+	// Highest score first, and lower seed first between equal scores
+	expected := []SeedBest{{42, 1500}, {3, 300}, {7, 300}}
+	bests, err := LoadSeedBests()
+	if err != nil {
+		t.Fatalf("Failed to load seed bests: %v", err)
+	}
+	if !reflect.DeepEqual(bests, expected) {
+		t.Errorf("Expected %v, got %v", expected, bests)
+	}
+}
+
+func TestLoadSeedBestCorruptFile(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+
+	dir := filepath.Join(home, ".cli-dino-game")
+	os.MkdirAll(dir, 0755)
+	os.WriteFile(filepath.Join(dir, "seed_bests.json"), []byte("not json"), 0644)
+
+	if _, err := LoadSeedBest(42); err == nil {
+		t.Error("Expected an error for a corrupt seed bests file")
+	}
+	if err := SaveSeedBest(42, 100); err == nil {
+		t.Error("Expected saving to refuse to overwrite a corrupt seed bests file")
+	}
+}
+
+func TestCompareSeedBest(t *testing.T) {
+	tests := []struct {
+		name     string
+		score    int
+		best     int
+		expected string
+	}{
+		{"first run", 800, 0, "First score for seed 42"},
+		{"new best", 1200, 1000, "New best for seed 42, beating 1000 by 200"},
+		{"matched", 1000, 1000, "Matched the best for seed 42"},
+		{"short", 700, 1000, "300 short of the best for seed 42 (1000)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CompareSeedBest(42, tt.score, tt.best); got != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,143 @@
+package settings
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"cli-dino-game/src/score"
+)
+
+// keyTutorialSeen is the key of the tutorial flag in the preferences file
+const keyTutorialSeen = "tutorial_seen"
+
+// Prefs holds everything remembered between launches
+type Prefs struct {
+	Settings
+	TutorialSeen bool `json:"tutorial_seen"`
+}
+
+// getPrefsFilePath returns the path to the preferences file in the score directory
+func getPrefsFilePath() (string, error) {
+	dataDir, err := score.GetDataDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dataDir, "prefs.json"), nil
+}
+
+// hasSavedPrefs reports whether a preferences file exists
+func hasSavedPrefs() bool {
+	filePath, err := getPrefsFilePath()
+	if err != nil {
+		return false
+	}
+	_, err = os.Stat(filePath)
+	return err == nil
+}
+
+// IsFirstRun reports whether nothing has been saved yet, neither preferences nor a high score
+func IsFirstRun() bool {
+	filePath, err := getPrefsFilePath()
+	if err != nil {
+		return false
+	}
+	if _, err := os.Stat(filePath); err == nil {
+		return false
+	}
+	return !score.HasSavedHighScore()
+}
+
+// MarkTutorialSeen remembers that the tutorial has been shown, keeping any other stored preferences
+func MarkTutorialSeen() error {
+	return updatePrefs(Prefs{TutorialSeen: true}, keyTutorialSeen)
+}
+
+// LoadPrefs loads saved preferences, returning defaults if none have been saved yet
+func LoadPrefs(defaults Prefs) (Prefs, error) {
+	filePath, err := getPrefsFilePath()
+	if err != nil {
+		return defaults, err
+	}
+
+	// If file doesn't exist, keep the defaults
+	if _, err := os.Stat(filePath); os.IsNotExist(err) {
+		return defaults, nil
+	}
+
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return defaults, fmt.Errorf("failed to read prefs file: %w", err)
+	}
+
+	// Start from the defaults so fields missing from the file keep their values
+	loaded := defaults
+	if err := json.Unmarshal(data, &loaded); err != nil {
+		return defaults, fmt.Errorf("failed to parse prefs file: %w", err)
+	}
+
+	return loaded, nil
+}
+
+// SavePrefs saves preferences to persistent storage
+func SavePrefs(p Prefs) error {
+	filePath, err := getPrefsFilePath()
+	if err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(p)
+	if err != nil {
+		return fmt.Errorf("failed to marshal prefs: %w", err)
+	}
+
+	if err := os.WriteFile(filePath, data, 0644); err != nil {
+		return fmt.Errorf("failed to write prefs file: %w", err)
+	}
+
+	return nil
+}
+
+// updatePrefs writes the given keys of p to the preferences file, leaving every
+// other stored key as it was
+func updatePrefs(p Prefs, keys ...string) error {
+	filePath, err := getPrefsFilePath()
+	if err != nil {
+		return err
+	}
+
+	stored := make(map[string]json.RawMessage)
+	data, err := os.ReadFile(filePath)
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to read prefs file: %w", err)
+	}
+	if err == nil {
+		if err := json.Unmarshal(data, &stored); err != nil {
+			return fmt.Errorf("failed to parse prefs file: %w", err)
+		}
+	}
+
+	data, err = json.Marshal(p)
+	if err != nil {
+		return fmt.Errorf("failed to marshal prefs: %w", err)
+	}
+	var values map[string]json.RawMessage
+	if err := json.Unmarshal(data, &values); err != nil {
+		return fmt.Errorf("failed to marshal prefs: %w", err)
+	}
+	for _, key := range keys {
+		stored[key] = values[key]
+	}
+
+	data, err = json.Marshal(stored)
+	if err != nil {
+		return fmt.Errorf("failed to marshal prefs: %w", err)
+	}
+	if err := os.WriteFile(filePath, data, 0644); err != nil {
+		return fmt.Errorf("failed to write prefs file: %w", err)
+	}
+
+	return nil
+}
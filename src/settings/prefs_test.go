@@ -0,0 +1,154 @@
+package settings
+
+import (
+	"os"
+	"testing"
+
+	"cli-dino-game/src/engine"
+	"cli-dino-game/src/score"
+)
+
+func TestPrefsPersistence(t *testing.T) {
+	// Override the home directory for testing
+	tempDir := t.TempDir()
+	originalHome := os.Getenv("HOME")
+	os.Setenv("HOME", tempDir)
+	defer os.Setenv("HOME", originalHome)
+
+	saved := Prefs{Settings: Settings{UseUnicode: false, SoundEnabled: false, Difficulty: engine.DifficultyEasy}}
+	if err := SavePrefs(saved); err != nil {
+		t.Fatalf("Failed to save prefs: %v", err)
+	}
+
+	loaded, err := LoadPrefs(Prefs{Settings: FromConfig(engine.NewDefaultConfig())})
+	if err != nil {
+		t.Fatalf("Failed to load prefs: %v", err)
+	}
+	if loaded != saved {
+		t.Errorf("Expected loaded prefs %+v, got %+v", saved, loaded)
+	}
+
+	// Loaded prefs seed the config
+	config := engine.NewDefaultConfig()
+	loaded.ApplyTo(config)
+	if config.UseUnicode || config.SoundEnabled || config.Difficulty != engine.DifficultyEasy {
+		t.Errorf("Expected config to reflect loaded prefs, got %+v", FromConfig(config))
+	}
+}
+
+func TestLoadPrefsPartialFile(t *testing.T) {
+	tempDir := t.TempDir()
+	originalHome := os.Getenv("HOME")
+	os.Setenv("HOME", tempDir)
+	defer os.Setenv("HOME", originalHome)
+
+	filePath, err := getPrefsFilePath()
+	if err != nil {
+		t.Fatalf("Failed to get prefs path: %v", err)
+	}
+	if err := os.WriteFile(filePath, []byte(`{"difficulty":"hard"}`), 0644); err != nil {
+		t.Fatalf("Failed to write prefs file: %v", err)
+	}
+
+	defaults := Prefs{Settings: FromConfig(engine.NewDefaultConfig())}
+	loaded, err := LoadPrefs(defaults)
+	if err != nil {
+		t.Fatalf("Failed to load prefs: %v", err)
+	}
+	if loaded.Difficulty != engine.DifficultyHard {
+		t.Errorf("Expected stored difficulty Hard, got %s", loaded.Difficulty)
+	}
+	if loaded.UseUnicode != defaults.UseUnicode || loaded.SoundEnabled != defaults.SoundEnabled {
+		t.Errorf("Fields missing from the file should keep their defaults, got %+v", loaded)
+	}
+}
+
+func TestTutorialFirstRun(t *testing.T) {
+	tempDir := t.TempDir()
+	originalHome := os.Getenv("HOME")
+	os.Setenv("HOME", tempDir)
+	defer os.Setenv("HOME", originalHome)
+
+	if !IsFirstRun() {
+		t.Fatal("Expected a first run with nothing saved")
+	}
+
+	// Without a stored flag the first-run default decides
+	prefs, err := LoadPrefs(Prefs{TutorialSeen: !IsFirstRun()})
+	if err != nil {
+		t.Fatalf("Failed to load prefs: %v", err)
+	}
+	if prefs.TutorialSeen {
+		t.Error("Expected the tutorial to show on a first run")
+	}
+
+	if err := MarkTutorialSeen(); err != nil {
+		t.Fatalf("Failed to mark the tutorial seen: %v", err)
+	}
+	if IsFirstRun() {
+		t.Error("Expected saved prefs to end the first run")
+	}
+	prefs, err = LoadPrefs(Prefs{})
+	if err != nil {
+		t.Fatalf("Failed to load prefs: %v", err)
+	}
+	if !prefs.TutorialSeen {
+		t.Error("Expected the tutorial to be skipped once seen")
+	}
+
+	// Saving settings keeps the flag
+	if err := SaveSettings(Settings{Difficulty: engine.DifficultyHard}, KeyDifficulty); err != nil {
+		t.Fatalf("Failed to save settings: %v", err)
+	}
+	if prefs, _ := LoadPrefs(Prefs{}); !prefs.TutorialSeen {
+		t.Error("Expected saving settings to keep the tutorial flag")
+	}
+}
+
+func TestMarkTutorialSeenKeepsSettings(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	// Only the difficulty has been changed from its default
+	if err := SaveSettings(Settings{Difficulty: engine.DifficultyHard}, KeyDifficulty); err != nil {
+		t.Fatalf("Failed to save settings: %v", err)
+	}
+	if err := MarkTutorialSeen(); err != nil {
+		t.Fatalf("Failed to mark the tutorial seen: %v", err)
+	}
+
+	defaults := Settings{UseUnicode: true, SoundEnabled: true, Difficulty: engine.DifficultyNormal}
+	prefs, err := LoadPrefs(Prefs{Settings: defaults})
+	if err != nil {
+		t.Fatalf("Failed to load prefs: %v", err)
+	}
+	expected := Settings{UseUnicode: true, SoundEnabled: true, Difficulty: engine.DifficultyHard}
+	if prefs.Settings != expected {
+		t.Errorf("Expected settings %+v to survive marking the tutorial seen, got %+v", expected, prefs.Settings)
+	}
+	if !prefs.TutorialSeen {
+		t.Error("Expected the tutorial to be marked seen")
+	}
+}
+
+func TestTutorialSkippedForExistingPlayers(t *testing.T) {
+	tempDir := t.TempDir()
+	originalHome := os.Getenv("HOME")
+	os.Setenv("HOME", tempDir)
+	defer os.Setenv("HOME", originalHome)
+
+	// A high score from before the tutorial existed
+	if err := score.SaveHighScore(500); err != nil {
+		t.Fatalf("Failed to save high score: %v", err)
+	}
+	if IsFirstRun() {
+		t.Fatal("Expected a saved high score to mean this isn't a first run")
+	}
+
+	// Their first settings change doesn't bring the tutorial back
+	if err := SaveSettings(Settings{Difficulty: engine.DifficultyEasy}, KeyDifficulty); err != nil {
+		t.Fatalf("Failed to save settings: %v", err)
+	}
+	if prefs, _ := LoadPrefs(Prefs{}); !prefs.TutorialSeen {
+		t.Error("Expected existing players to skip the tutorial")
+	}
+}
@@ -0,0 +1,56 @@
+package settings
+
+import (
+	"cli-dino-game/src/engine"
+	"cli-dino-game/src/score"
+)
+
+// Keys of the settings in the preferences file
+const (
+	KeyUnicode    = "use_unicode"
+	KeySound      = "sound_enabled"
+	KeyDifficulty = "difficulty"
+)
+
+// Settings holds the options players can change from the in-game settings screen
+type Settings struct {
+	UseUnicode   bool              `json:"use_unicode"`
+	SoundEnabled bool              `json:"sound_enabled"`
+	Difficulty   engine.Difficulty `json:"difficulty"`
+}
+
+// FromConfig captures the current settings from a config
+func FromConfig(config *engine.Config) Settings {
+	return Settings{
+		UseUnicode:   config.UseUnicode,
+		SoundEnabled: config.SoundEnabled,
+		Difficulty:   config.Difficulty,
+	}
+}
+
+// ApplyTo writes the settings into a config, including the difficulty preset values
+func (s Settings) ApplyTo(config *engine.Config) {
+	config.UseUnicode = s.UseUnicode
+	config.SoundEnabled = s.SoundEnabled
+	config.ApplyDifficulty(s.Difficulty)
+}
+
+// LoadSettings loads the saved settings, returning defaults if none have been saved yet
+func LoadSettings(defaults Settings) (Settings, error) {
+	prefs, err := LoadPrefs(Prefs{Settings: defaults})
+	return prefs.Settings, err
+}
+
+// SaveSettings saves the settings with the given keys to the preferences file,
+// keeping any other stored preferences. Settings never saved stay unset, so the
+// defaults detected at launch still decide them.
+func SaveSettings(s Settings, keys ...string) error {
+	prefs := Prefs{Settings: s}
+
+	// Players from before the tutorial existed have already learned the game
+	if !hasSavedPrefs() && score.HasSavedHighScore() {
+		prefs.TutorialSeen = true
+		keys = append(keys, keyTutorialSeen)
+	}
+	return updatePrefs(prefs, keys...)
+}
@@ -0,0 +1,130 @@
+package settings
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"cli-dino-game/src/engine"
+)
+
+func TestSettingsPersistenceRoundTrip(t *testing.T) {
+	// Override the home directory for testing
+	tempDir := t.TempDir()
+	originalHome := os.Getenv("HOME")
+	os.Setenv("HOME", tempDir)
+	defer os.Setenv("HOME", originalHome)
+
+	saved := Settings{
+		UseUnicode:   false,
+		SoundEnabled: false,
+		Difficulty:   engine.DifficultyHard,
+	}
+	if err := SaveSettings(saved, KeyUnicode, KeySound, KeyDifficulty); err != nil {
+		t.Fatalf("Failed to save settings: %v", err)
+	}
+
+	loaded, err := LoadSettings(FromConfig(engine.NewDefaultConfig()))
+	if err != nil {
+		t.Fatalf("Failed to load settings: %v", err)
+	}
+	if loaded != saved {
+		t.Errorf("Expected loaded settings %+v, got %+v", saved, loaded)
+	}
+}
+
+func TestSaveSettingsKeepsUnsavedDefaults(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	// Unicode was detected at launch and only the difficulty was changed
+	config := engine.NewDefaultConfig()
+	config.UseUnicode = false
+	config.ApplyDifficulty(engine.DifficultyHard)
+	if err := SaveSettings(FromConfig(config), KeyDifficulty); err != nil {
+		t.Fatalf("Failed to save settings: %v", err)
+	}
+
+	// A later launch on a terminal detected as Unicode-capable still uses it
+	defaults := FromConfig(engine.NewDefaultConfig())
+	loaded, err := LoadSettings(defaults)
+	if err != nil {
+		t.Fatalf("Failed to load settings: %v", err)
+	}
+	if !loaded.UseUnicode {
+		t.Error("Expected Unicode to follow detection when it was never saved")
+	}
+	if loaded.Difficulty != engine.DifficultyHard {
+		t.Errorf("Expected the saved difficulty Hard, got %s", loaded.Difficulty)
+	}
+
+	// Saving another setting keeps the difficulty
+	if err := SaveSettings(Settings{SoundEnabled: true}, KeySound); err != nil {
+		t.Fatalf("Failed to save settings: %v", err)
+	}
+	loaded, _ = LoadSettings(defaults)
+	if !loaded.SoundEnabled || loaded.Difficulty != engine.DifficultyHard || !loaded.UseUnicode {
+		t.Errorf("Expected sound on, Hard and detected Unicode, got %+v", loaded)
+	}
+}
+
+func TestLoadSettingsNonExistentFile(t *testing.T) {
+	tempDir := t.TempDir()
+	originalHome := os.Getenv("HOME")
+	os.Setenv("HOME", tempDir)
+	defer os.Setenv("HOME", originalHome)
+
+	defaults := FromConfig(engine.NewDefaultConfig())
+	loaded, err := LoadSettings(defaults)
+	if err != nil {
+		t.Fatalf("Expected no error for missing settings file, got: %v", err)
+	}
+	if loaded != defaults {
+		t.Errorf("Expected defaults %+v, got %+v", defaults, loaded)
+	}
+}
+
+func TestLoadSettingsInvalidFile(t *testing.T) {
+	tempDir := t.TempDir()
+	originalHome := os.Getenv("HOME")
+	os.Setenv("HOME", tempDir)
+	defer os.Setenv("HOME", originalHome)
+
+	filePath, err := getPrefsFilePath()
+	if err != nil {
+		t.Fatalf("Failed to get prefs path: %v", err)
+	}
+	if filepath.Dir(filePath) != filepath.Join(tempDir, ".cli-dino-game") {
+		t.Errorf("Prefs should live in the score directory, got %s", filePath)
+	}
+	if err := os.WriteFile(filePath, []byte(`{"difficulty":"nightmare"}`), 0644); err != nil {
+		t.Fatalf("Failed to write prefs file: %v", err)
+	}
+
+	defaults := FromConfig(engine.NewDefaultConfig())
+	loaded, err := LoadSettings(defaults)
+	if err == nil {
+		t.Error("Expected an error for an unknown difficulty")
+	}
+	if loaded != defaults {
+		t.Errorf("Expected defaults after a failed load, got %+v", loaded)
+	}
+}
+
+func TestLoadedSettingsProduceValidConfig(t *testing.T) {
+	difficulties := []engine.Difficulty{engine.DifficultyEasy, engine.DifficultyNormal, engine.DifficultyHard}
+
+	for _, d := range difficulties {
+		t.Run(d.String(), func(t *testing.T) {
+			config := engine.NewDefaultConfig()
+			s := Settings{UseUnicode: false, SoundEnabled: true, Difficulty: d}
+			s.ApplyTo(config)
+
+			if err := config.Validate(); err != nil {
+				t.Errorf("Expected valid config, got: %v", err)
+			}
+			if FromConfig(config) != s {
+				t.Errorf("Expected config to reflect settings %+v, got %+v", s, FromConfig(config))
+			}
+		})
+	}
+}
@@ -1,39 +1,87 @@
 package spawner
 
 import (
+	"cli-dino-game/src/clock"
 	"cli-dino-game/src/engine"
 	"cli-dino-game/src/entities"
+	"errors"
+	"fmt"
+	"math"
 	"math/rand"
 	"time"
 )
 
+// DifficultyBasis selects what drives difficulty progression
+type DifficultyBasis int
+
+const (
+	BasisTime     DifficultyBasis = iota // Difficulty ramps with time survived
+	BasisDistance                        // Difficulty ramps with distance traveled
+)
+
+// String returns the string representation of DifficultyBasis
+func (b DifficultyBasis) String() string {
+	switch b {
+	case BasisTime:
+		return "Time"
+	case BasisDistance:
+		return "Distance"
+	default:
+		return "Unknown"
+	}
+}
+
 // ObstacleSpawner manages the spawning of obstacles with random intervals and difficulty progression
 type ObstacleSpawner struct {
 	config         *engine.Config
 	obstacles      []*entities.Obstacle
+	free           []*entities.Obstacle // Retired obstacles kept for reuse by spawnObstacle
+	clock          clock.Clock // Time source for spawn timing and obstacle animation
 	lastSpawnTime  time.Time
 	nextSpawnDelay time.Duration
 	gameTime       float64
+	distance       float64 // Distance the world has scrolled, integrating obstacle speed over time
 	screenWidth    float64
 	groundLevel    float64
 	rng            *rand.Rand
+	frozen         bool      // Whether obstacles are held in place for inspection
+	frozenAt       time.Time // When the spawner was frozen, to pause the spawn timer
 
 	// Difficulty progression parameters
 	baseSpawnRate    float64 // Base spawn rate (obstacles per second)
 	maxSpawnRate     float64 // Maximum spawn rate
 	difficultyRamp   float64 // How quickly difficulty increases
+	difficultyBasis  DifficultyBasis
+	warmupDuration   time.Duration // Time for obstacle speed to ease up to full speed at the start of a run
 	minSpawnInterval time.Duration
 	maxSpawnInterval time.Duration
+	minGap           float64 // Smallest random gap between obstacles before difficulty narrows it
+	maxGap           float64 // Largest random gap between obstacles before difficulty narrows it
+	birdIntroTime    float64 // Seconds of difficulty progress before birds can appear
+	birdRampDuration float64 // Seconds over which birds grow from rare to full weighting
+
+	// Cap on how much faster than their base speed obstacles get
+	maxSpeedMultiplier float64
+
+	// Rows cacti may sit above or below the ground line, for variety (0 keeps them on it)
+	groundJitter int
 
 	// Obstacle type distribution
 	typeWeights map[entities.ObstacleType]float64
+
+	// Practice mode: only practiceType spawns, every practiceInterval, at the screen edge
+	practice         bool
+	practiceType     entities.ObstacleType
+	practiceInterval time.Duration
 }
 
-// NewObstacleSpawner creates a new obstacle spawner
+// NewObstacleSpawner creates a new obstacle spawner. Obstacles stand on groundLevel,
+// the obstacle ground from engine.GroundModel.
 func NewObstacleSpawner(config *engine.Config, screenWidth, groundLevel float64) *ObstacleSpawner {
 	spawner := &ObstacleSpawner{
 		config:           config,
 		obstacles:        make([]*entities.Obstacle, 0, 10), // Pre-allocate for efficiency
+		clock:            clock.Real,
 		screenWidth:      screenWidth,
 		groundLevel:      groundLevel,
 		rng:              rand.New(rand.NewSource(time.Now().UnixNano())),
@@ -42,6 +90,10 @@ func NewObstacleSpawner(config *engine.Config, screenWidth, groundLevel float64)
 		difficultyRamp:   0.02,                    // Difficulty increases by 2% every 10 seconds (much gentler)
 		minSpawnInterval: time.Millisecond * 800,  // Minimum 0.8 seconds between spawns (increased)
 		maxSpawnInterval: time.Millisecond * 4000, // Maximum 4.0 seconds between spawns (increased)
+		minGap:           25.0,                    // Minimum distance for jumpability (increased from 15)
+		maxGap:           60.0,                    // Maximum distance (increased from 45)
+		birdIntroTime:    25.0,                    // Birds join after 25 seconds (reduced from 30)
+		birdRampDuration: 30.0,                    // Birds reach full strength 30 seconds later (reduced from 60)
 		typeWeights: map[entities.ObstacleType]float64{
 			entities.CactusSmall:  0.50, // 50% chance (increased for easier gameplay)
 			entities.CactusMedium: 0.30, // 30% chance 
@@ -50,6 +102,7 @@ func NewObstacleSpawner(config *engine.Config, screenWidth, groundLevel float64)
 			entities.BirdMid:      0.00, // 0% chance initially
 			entities.BirdHigh:     0.00, // 0% chance initially
 		},
+		maxSpeedMultiplier: DefaultMaxSpeedMultiplier,
 	}
 
 	// Initialize first spawn delay
@@ -61,22 +114,68 @@ func NewObstacleSpawner(config *engine.Config, screenWidth, groundLevel float64)
 func (s *ObstacleSpawner) Update(deltaTime float64) {
 	s.gameTime += deltaTime
 
+	// Frozen obstacles neither move nor spawn
+	if s.frozen {
+		return
+	}
+
+	s.distance += s.GetEffectiveObstacleSpeed() * deltaTime
+
 	// Check if it's time to spawn a new obstacle
-	if time.Since(s.lastSpawnTime) >= s.nextSpawnDelay {
+	now := s.clock.Now()
+	if now.Sub(s.lastSpawnTime) >= s.nextSpawnDelay {
 		s.spawnObstacle()
 		s.scheduleNextSpawn()
 	}
 
-	// Update all active obstacles
-	for i := len(s.obstacles) - 1; i >= 0; i-- {
-		obstacle := s.obstacles[i]
-		obstacle.Update(deltaTime)
-
-		// Remove inactive obstacles for memory efficiency
-		if !obstacle.IsActive() {
-			s.removeObstacle(i)
+	// Update all obstacles and drop the ones that left the screen in the same pass,
+	// reading the clock once for every bird's animation
+	kept := s.obstacles[:0]
+	for _, obstacle := range s.obstacles {
+		obstacle.UpdateAt(deltaTime, now)
+		if obstacle.Active {
+			kept = append(kept, obstacle)
+		} else {
+			s.free = append(s.free, obstacle)
 		}
 	}
+	s.clearTail(kept)
+}
+
+// clearTail replaces the obstacle list with kept, a prefix of it, clearing the
+// dropped tail so the slice holds no stale references
+func (s *ObstacleSpawner) clearTail(kept []*entities.Obstacle) {
+	for i := len(kept); i < len(s.obstacles); i++ {
+		s.obstacles[i] = nil
+	}
+	s.obstacles = kept
+}
+
+// acquireObstacle returns a retired obstacle reinitialized for reuse, or a new one if none are free
+func (s *ObstacleSpawner) acquireObstacle(obstType entities.ObstacleType, x float64) *entities.Obstacle {
+	if n := len(s.free); n > 0 {
+		obstacle := s.free[n-1]
+		s.free[n-1] = nil
+		s.free = s.free[:n-1]
+		obstacle.Reset(obstType, x, s.groundLevel, s.config)
+		return obstacle
+	}
+	return entities.NewObstacle(obstType, x, s.groundLevel, s.config)
+}
+
+// Prewarm allocates obstacles up front so the first spawns of a run reuse them
+func (s *ObstacleSpawner) Prewarm(n int) {
+	for i := 0; i < n; i++ {
+		obstacle := entities.NewObstacle(entities.CactusSmall, 0, s.groundLevel, s.config)
+		obstacle.Deactivate()
+		obstacle.SetClock(s.clock)
+		s.free = append(s.free, obstacle)
+	}
+}
+
+// GetFreeObstacleCount returns the number of retired obstacles waiting to be reused
+func (s *ObstacleSpawner) GetFreeObstacleCount() int {
+	return len(s.free)
 }
 
 // spawnObstacle creates and spawns a new obstacle
@@ -87,20 +186,50 @@ func (s *ObstacleSpawner) spawnObstacle() {
 	// Calculate spawn position with proper spacing
 	spawnX := s.calculateSpawnPosition()
 
-	// Create new obstacle
-	obstacle := entities.NewObstacle(obstType, spawnX, s.groundLevel, s.config)
+	// Reuse a retired obstacle when one is available
+	obstacle := s.acquireObstacle(obstType, spawnX)
+	obstacle.SetClock(s.clock)
 
-	// Apply current difficulty speed multiplier
-	speedMultiplier := s.getDifficultySpeedMultiplier()
+	// Apply current difficulty speed multiplier, slowed during warm-up
+	speedMultiplier := s.getDifficultySpeedMultiplier() * s.getWarmupMultiplier()
 	obstacle.SetSpeed(obstacle.GetSpeed() * speedMultiplier)
 
+	// Raise or bury cacti a little when jitter is on
+	s.applyGroundJitter(obstacle)
+
 	// Add to obstacle list
 	s.obstacles = append(s.obstacles, obstacle)
-	s.lastSpawnTime = time.Now()
+	s.lastSpawnTime = s.clock.Now()
+}
+
+// applyGroundJitter moves a cactus up to groundJitter rows above or below the
+// ground line, in whole rows so its bounds match where it is drawn. Cacti are
+// never raised past what a jump clears.
+func (s *ObstacleSpawner) applyGroundJitter(obstacle *entities.Obstacle) {
+	if s.groundJitter == 0 {
+		return
+	}
+	switch obstacle.GetType() {
+	case entities.CactusSmall, entities.CactusMedium, entities.CactusLarge:
+	default:
+		return
+	}
+
+	offset := s.rng.Intn(2*s.groundJitter+1) - s.groundJitter
+	maxRaise := int(math.Max(entities.JumpHeight(s.config)-obstacle.Height, 0))
+	if offset < -maxRaise {
+		offset = -maxRaise
+	}
+	obstacle.Y += float64(offset)
 }
 
 // scheduleNextSpawn calculates the delay until the next obstacle spawn
 func (s *ObstacleSpawner) scheduleNextSpawn() {
+	if s.practice {
+		s.nextSpawnDelay = s.practiceInterval
+		return
+	}
+
 	// Calculate current spawn rate based on difficulty progression
 	currentSpawnRate := s.getCurrentSpawnRate()
 
@@ -127,6 +256,11 @@ func (s *ObstacleSpawner) calculateSpawnPosition() float64 {
 	// Base spawn position (just off-screen)
 	baseSpawnX := s.screenWidth + 2.0
 
+	// Practice obstacles are spaced by time alone
+	if s.practice {
+		return baseSpawnX
+	}
+
 	// Find the rightmost active obstacle that's still relevant for spacing
 	rightmostX := baseSpawnX
 	for _, obstacle := range s.obstacles {
@@ -138,26 +272,8 @@ func (s *ObstacleSpawner) calculateSpawnPosition() float64 {
 		}
 	}
 
-	// Define minimum and maximum gaps between obstacles with progressive difficulty
-	baseMinGap := 25.0 // Base minimum distance for jumpability (increased from 15)
-	baseMaxGap := 60.0 // Base maximum distance (increased from 45)
-	
-	// Gradually reduce gaps as game progresses, but much more slowly
-	difficultyReduction := s.gameTime * 0.1 // Very slow gap reduction
-	if difficultyReduction > 8.0 { // Cap the reduction
-		difficultyReduction = 8.0
-	}
-	
-	minGap := baseMinGap - difficultyReduction
-	maxGap := baseMaxGap - difficultyReduction
-	
-	// Ensure minimum gaps don't go below reasonable limits
-	if minGap < 18.0 {
-		minGap = 18.0
-	}
-	if maxGap < 30.0 {
-		maxGap = 30.0
-	}
+	// Narrow the configured gap range between obstacles with progressive difficulty
+	minGap, maxGap := s.getGapRange()
 
 	// Generate random gap within the range
 	randomGap := minGap + s.rng.Float64()*(maxGap-minGap)
@@ -173,40 +289,53 @@ func (s *ObstacleSpawner) calculateSpawnPosition() float64 {
 	return spawnX
 }
 
-// selectObstacleType chooses an obstacle type based on weighted distribution and game time
-func (s *ObstacleSpawner) selectObstacleType() entities.ObstacleType {
-	// Create dynamic weights based on game time
-	weights := make(map[entities.ObstacleType]float64)
+// getGapRange returns the range of random gaps between obstacles, narrowed by
+// difficulty progress
+func (s *ObstacleSpawner) getGapRange() (float64, float64) {
+	// Gradually reduce gaps as game progresses, but much more slowly
+	difficultyReduction := s.difficultyProgress() * 0.1 // Very slow gap reduction
+	if difficultyReduction > 8.0 {                      // Cap the reduction
+		difficultyReduction = 8.0
+	}
 
-	// Always include cacti with base weights
-	weights[entities.CactusSmall] = 0.5
-	weights[entities.CactusMedium] = 0.3
-	weights[entities.CactusLarge] = 0.2
-
-	// Only include birds after 25 seconds of gameplay (reduced from 30 seconds)
-	if s.gameTime > 25.0 {
-		// Gradual bird introduction - reaches full strength after 30 seconds (reduced from 60)
-		birdMultiplier := (s.gameTime - 25.0) / 30.0 // Takes 30 seconds to reach full strength
-		if birdMultiplier > 1.0 {
-			birdMultiplier = 1.0
-		}
+	minGap := s.minGap - difficultyReduction
+	maxGap := s.maxGap - difficultyReduction
 
-		// Increased bird weights for more variety while keeping cacti primary
-		weights[entities.BirdLow] = 0.12 * birdMultiplier  // 12% at full strength (increased from 5%)
-		weights[entities.BirdMid] = 0.08 * birdMultiplier  // 8% at full strength (increased from 3%)
-		weights[entities.BirdHigh] = 0.05 * birdMultiplier // 5% at full strength (increased from 2%)
+	// Ensure gaps don't go below reasonable limits, or below a range configured smaller than them
+	if floor := math.Min(18.0, s.minGap); minGap < floor {
+		minGap = floor
+	}
+	if floor := math.Min(30.0, s.maxGap); maxGap < floor {
+		maxGap = floor
+	}
+	return minGap, maxGap
+}
 
-		// Only slightly reduce cactus weights to make room for birds
-		totalBirdWeight := weights[entities.BirdLow] + weights[entities.BirdMid] + weights[entities.BirdHigh]
-		weights[entities.CactusSmall] = 0.5 - (totalBirdWeight * 0.3)
-		weights[entities.CactusMedium] = 0.3 - (totalBirdWeight * 0.4)
-		weights[entities.CactusLarge] = 0.2 - (totalBirdWeight * 0.3)
+// Bird weights at full strength, out of a total weight of 1 shared with the cacti
+const (
+	birdLowWeight  = 0.12 // 12% at full strength (increased from 5%)
+	birdMidWeight  = 0.08 // 8% at full strength (increased from 3%)
+	birdHighWeight = 0.05 // 5% at full strength (increased from 2%)
+)
+
+// typeWeight is an obstacle type's share of the spawns
+type typeWeight struct {
+	obstType entities.ObstacleType
+	weight   float64
+}
+
+// selectObstacleType chooses an obstacle type based on weighted distribution and game time
+func (s *ObstacleSpawner) selectObstacleType() entities.ObstacleType {
+	if s.practice {
+		return s.practiceType
 	}
 
+	weights := s.getTypeWeights()
+
 	// Calculate total weight
 	totalWeight := 0.0
-	for _, weight := range weights {
-		totalWeight += weight
+	for _, tw := range weights {
+		totalWeight += tw.weight
 	}
 
 	// Generate random value
@@ -214,10 +343,10 @@ func (s *ObstacleSpawner) selectObstacleType() entities.ObstacleType {
 
 	// Select type based on cumulative weights
 	cumulative := 0.0
-	for obstType, weight := range weights {
-		cumulative += weight
+	for _, tw := range weights {
+		cumulative += tw.weight
 		if randomValue <= cumulative {
-			return obstType
+			return tw.obstType
 		}
 	}
 
@@ -225,10 +354,54 @@ func (s *ObstacleSpawner) selectObstacleType() entities.ObstacleType {
 	return entities.CactusSmall
 }
 
+// getTypeWeights returns each obstacle type's weight at the current point in the
+// run. The order is fixed, unlike a map's, so a seed always picks the same obstacles.
+func (s *ObstacleSpawner) getTypeWeights() []typeWeight {
+	// Always include cacti with base weights
+	small, medium, large := 0.5, 0.3, 0.2
+	var birdLow, birdMid, birdHigh float64
+
+	// Only include birds once they have been introduced
+	if birdMultiplier := s.getBirdMultiplier(); birdMultiplier > 0 {
+		// Increased bird weights for more variety while keeping cacti primary
+		birdLow = birdLowWeight * birdMultiplier
+		birdMid = birdMidWeight * birdMultiplier
+		birdHigh = birdHighWeight * birdMultiplier
+
+		// Only slightly reduce cactus weights to make room for birds
+		totalBirdWeight := birdLow + birdMid + birdHigh
+		small = 0.5 - (totalBirdWeight * 0.3)
+		medium = 0.3 - (totalBirdWeight * 0.4)
+		large = 0.2 - (totalBirdWeight * 0.3)
+	}
+
+	return []typeWeight{
+		{entities.CactusSmall, small},
+		{entities.CactusMedium, medium},
+		{entities.CactusLarge, large},
+		{entities.BirdLow, birdLow},
+		{entities.BirdMid, birdMid},
+		{entities.BirdHigh, birdHigh},
+	}
+}
+
+// getBirdMultiplier returns how close birds are to full weighting, from 0 before
+// their introduction to 1 once the ramp has finished
+func (s *ObstacleSpawner) getBirdMultiplier() float64 {
+	progress := s.difficultyProgress()
+	if progress <= s.birdIntroTime {
+		return 0
+	}
+	if s.birdRampDuration <= 0 {
+		return 1
+	}
+	return math.Min((progress-s.birdIntroTime)/s.birdRampDuration, 1)
+}
+
 // getCurrentSpawnRate calculates the current spawn rate based on difficulty progression
 func (s *ObstacleSpawner) getCurrentSpawnRate() float64 {
 	// Increase spawn rate over time - much more gradually
-	difficultyMultiplier := 1.0 + (s.gameTime * s.difficultyRamp / 30.0) // Now takes 30 seconds for each 2% increase
+	difficultyMultiplier := 1.0 + (s.difficultyProgress() * s.difficultyRamp / 30.0) // Now takes 30 seconds for each 2% increase
 	currentRate := s.baseSpawnRate * difficultyMultiplier
 
 	// Cap at maximum spawn rate
@@ -239,34 +412,70 @@ func (s *ObstacleSpawner) getCurrentSpawnRate() float64 {
 	return currentRate
 }
 
-// getDifficultySpeedMultiplier calculates speed multiplier based on game time
+// difficultyProgress returns how far difficulty has progressed, in seconds.
+// With BasisDistance this is the time the distance traveled would take at base speed,
+// so it runs ahead of game time once obstacles speed up.
+func (s *ObstacleSpawner) difficultyProgress() float64 {
+	if s.difficultyBasis == BasisDistance && s.config.ObstacleSpeed > 0 {
+		return s.distance / s.config.GetObstacleSpeed()
+	}
+	return s.gameTime
+}
+
+// getDifficultySpeedMultiplier calculates speed multiplier based on difficulty progress
 func (s *ObstacleSpawner) getDifficultySpeedMultiplier() float64 {
 	// Gradually increase obstacle speed over time - much more gradually
-	speedIncrease := 1.0 + (s.gameTime * 0.02 / 10.0) // 2% increase every 10 seconds (was 10% every 5 seconds)
-	maxSpeedMultiplier := 1.8                         // Cap at 1.8x speed (reduced from 2.5x)
+	speedIncrease := 1.0 + (s.difficultyProgress() * 0.02 / 10.0) // 2% increase every 10 seconds (was 10% every 5 seconds)
 
-	if speedIncrease > maxSpeedMultiplier {
-		speedIncrease = maxSpeedMultiplier
+	if speedIncrease > s.maxSpeedMultiplier {
+		speedIncrease = s.maxSpeedMultiplier
 	}
 
 	return speedIncrease
 }
 
-// removeObstacle removes an obstacle at the specified index
-func (s *ObstacleSpawner) removeObstacle(index int) {
-	// Efficient removal by swapping with last element
-	lastIndex := len(s.obstacles) - 1
-	if index != lastIndex {
-		s.obstacles[index] = s.obstacles[lastIndex]
+// DefaultMaxSpeedMultiplier is how much faster than their base speed obstacles
+// get at most, keeping late runs playable
+const DefaultMaxSpeedMultiplier = 1.8
+
+// warmupStartMultiplier is the fraction of full speed obstacles move at when a run starts
+const warmupStartMultiplier = 0.5
+
+// getWarmupMultiplier eases obstacle speed from warmupStartMultiplier to 1.0 over the warm-up
+func (s *ObstacleSpawner) getWarmupMultiplier() float64 {
+	warmup := s.warmupDuration.Seconds()
+	if warmup <= 0 || s.gameTime >= warmup {
+		return 1.0
 	}
-	s.obstacles = s.obstacles[:lastIndex]
+	return warmupStartMultiplier + (1.0-warmupStartMultiplier)*(s.gameTime/warmup)
 }
 
-// GetObstacles returns all active obstacles
+// GetEffectiveObstacleSpeed returns the speed newly spawned obstacles move at
+func (s *ObstacleSpawner) GetEffectiveObstacleSpeed() float64 {
+	return s.config.GetObstacleSpeed() * s.getDifficultySpeedMultiplier() * s.getWarmupMultiplier()
+}
+
+// GetObstacles returns all active obstacles. Retired obstacles are reused by later
+// spawns, so callers should not keep them across updates.
 func (s *ObstacleSpawner) GetObstacles() []*entities.Obstacle {
 	return s.obstacles
 }
 
+// GetNearestObstacleAhead returns the closest active obstacle whose left edge is at or beyond x,
+// or nil if there is none
+func (s *ObstacleSpawner) GetNearestObstacleAhead(x float64) *entities.Obstacle {
+	var nearest *entities.Obstacle
+	for _, obstacle := range s.obstacles {
+		if !obstacle.IsActive() || obstacle.X < x {
+			continue
+		}
+		if nearest == nil || obstacle.X < nearest.X {
+			nearest = obstacle
+		}
+	}
+	return nearest
+}
+
 // GetActiveObstacleCount returns the number of active obstacles
 func (s *ObstacleSpawner) GetActiveObstacleCount() int {
 	return len(s.obstacles)
@@ -274,12 +483,91 @@ func (s *ObstacleSpawner) GetActiveObstacleCount() int {
 
 // Reset resets the spawner state for a new game
 func (s *ObstacleSpawner) Reset() {
-	s.obstacles = s.obstacles[:0] // Clear slice but keep capacity
+	// Retire every obstacle to the free list, then clear the slice but keep capacity
+	for i, obstacle := range s.obstacles {
+		s.free = append(s.free, obstacle)
+		s.obstacles[i] = nil
+	}
+	s.obstacles = s.obstacles[:0]
 	s.gameTime = 0.0
-	s.lastSpawnTime = time.Now()
+	s.distance = 0.0
+	s.frozen = false
+	s.lastSpawnTime = s.clock.Now()
 	s.scheduleNextSpawn()
 }
 
+// SetClock sets the time source for spawn timing and obstacle animation
+func (s *ObstacleSpawner) SetClock(c clock.Clock) {
+	s.clock = c
+	for _, obstacle := range s.obstacles {
+		obstacle.SetClock(c)
+	}
+	for _, obstacle := range s.free {
+		obstacle.SetClock(c)
+	}
+}
+
+// SetFrozen holds obstacles in place and stops spawning while frozen. Game time
+// keeps advancing, and the spawn timer picks up where it left off when unfrozen.
+func (s *ObstacleSpawner) SetFrozen(frozen bool) {
+	if frozen == s.frozen {
+		return
+	}
+	if frozen {
+		s.frozenAt = s.clock.Now()
+	} else {
+		s.lastSpawnTime = s.lastSpawnTime.Add(s.clock.Now().Sub(s.frozenAt))
+	}
+	s.frozen = frozen
+}
+
+// IsFrozen returns whether obstacles are held in place
+func (s *ObstacleSpawner) IsFrozen() bool {
+	return s.frozen
+}
+
+// SetSpawnGapRange sets the range of random gaps left between spawned obstacles
+func (s *ObstacleSpawner) SetSpawnGapRange(min, max float64) error {
+	if min <= 0 {
+		return errors.New("minimum spawn gap must be positive")
+	}
+	if min >= max {
+		return errors.New("minimum spawn gap must be less than the maximum")
+	}
+	s.minGap = min
+	s.maxGap = max
+	return nil
+}
+
+// GetSpawnGapRange returns the range of random gaps left between spawned obstacles
+func (s *ObstacleSpawner) GetSpawnGapRange() (float64, float64) {
+	return s.minGap, s.maxGap
+}
+
+// SetSpawnIntervalRange sets the shortest and longest time allowed between spawns,
+// whatever the spawn rate asks for
+func (s *ObstacleSpawner) SetSpawnIntervalRange(min, max time.Duration) error {
+	if min <= 0 {
+		return errors.New("minimum spawn interval must be positive")
+	}
+	if min >= max {
+		return errors.New("minimum spawn interval must be less than the maximum")
+	}
+	s.minSpawnInterval = min
+	s.maxSpawnInterval = max
+	return nil
+}
+
+// GetSpawnIntervalRange returns the shortest and longest time allowed between spawns
+func (s *ObstacleSpawner) GetSpawnIntervalRange() (time.Duration, time.Duration) {
+	return s.minSpawnInterval, s.maxSpawnInterval
+}
+
+// SetSeed reseeds the random number generator so spawn sequences are reproducible
+func (s *ObstacleSpawner) SetSeed(seed int64) {
+	s.rng = rand.New(rand.NewSource(seed))
+}
+
 // SetDifficulty allows manual adjustment of difficulty parameters
 func (s *ObstacleSpawner) SetDifficulty(baseRate, maxRate, ramp float64) {
 	s.baseSpawnRate = baseRate
@@ -287,6 +575,100 @@ func (s *ObstacleSpawner) SetDifficulty(baseRate, maxRate, ramp float64) {
 	s.difficultyRamp = ramp
 }
 
+// SetGroundLevel changes where new obstacles sit
+func (s *ObstacleSpawner) SetGroundLevel(groundLevel float64) {
+	s.groundLevel = groundLevel
+}
+
+// SetSpawnRate changes the base spawn rate, keeping the maximum at twice the base
+func (s *ObstacleSpawner) SetSpawnRate(rate float64) {
+	s.baseSpawnRate = rate
+	s.maxSpawnRate = rate * 2.0
+}
+
+// SetDifficultyBasis chooses whether difficulty ramps with time or distance
+func (s *ObstacleSpawner) SetDifficultyBasis(basis DifficultyBasis) {
+	s.difficultyBasis = basis
+}
+
+// GetDifficultyBasis returns what drives difficulty progression
+func (s *ObstacleSpawner) GetDifficultyBasis() DifficultyBasis {
+	return s.difficultyBasis
+}
+
+// SetMaxSpeedMultiplier caps how much faster than their base speed obstacles
+// get as difficulty rises
+func (s *ObstacleSpawner) SetMaxSpeedMultiplier(multiplier float64) error {
+	if multiplier < 1.0 {
+		return errors.New("maximum speed multiplier must be at least 1")
+	}
+	s.maxSpeedMultiplier = multiplier
+	return nil
+}
+
+// GetMaxSpeedMultiplier returns the cap on how much faster than their base speed obstacles get
+func (s *ObstacleSpawner) GetMaxSpeedMultiplier() float64 {
+	return s.maxSpeedMultiplier
+}
+
+// MaxGroundJitter is the most rows cacti may be raised or buried
+const MaxGroundJitter = 2
+
+// SetGroundJitter sets how many rows cacti may sit above or below the ground
+// line, up to MaxGroundJitter (0 keeps them on it)
+func (s *ObstacleSpawner) SetGroundJitter(rows int) error {
+	if rows < 0 || rows > MaxGroundJitter {
+		return fmt.Errorf("ground jitter must be between 0 and %d rows", MaxGroundJitter)
+	}
+	s.groundJitter = rows
+	return nil
+}
+
+// GetGroundJitter returns how many rows cacti may sit above or below the ground line
+func (s *ObstacleSpawner) GetGroundJitter() int {
+	return s.groundJitter
+}
+
+// SetWarmupDuration sets how long obstacles take to reach full speed at the start of a run (0 disables warm-up)
+func (s *ObstacleSpawner) SetWarmupDuration(d time.Duration) {
+	if d < 0 {
+		d = 0
+	}
+	s.warmupDuration = d
+}
+
+// GetWarmupDuration returns how long obstacles take to reach full speed at the start of a run
+func (s *ObstacleSpawner) GetWarmupDuration() time.Duration {
+	return s.warmupDuration
+}
+
+// SetBirdIntroTime sets how many seconds of difficulty progress pass before birds can appear
+func (s *ObstacleSpawner) SetBirdIntroTime(seconds float64) {
+	if seconds < 0 {
+		seconds = 0
+	}
+	s.birdIntroTime = seconds
+}
+
+// GetBirdIntroTime returns how many seconds of difficulty progress pass before birds can appear
+func (s *ObstacleSpawner) GetBirdIntroTime() float64 {
+	return s.birdIntroTime
+}
+
+// SetBirdRampDuration sets how many seconds after their introduction birds take to
+// reach full weighting (0 brings them in at full weighting)
+func (s *ObstacleSpawner) SetBirdRampDuration(seconds float64) {
+	if seconds < 0 {
+		seconds = 0
+	}
+	s.birdRampDuration = seconds
+}
+
+// GetBirdRampDuration returns how many seconds birds take to reach full weighting
+func (s *ObstacleSpawner) GetBirdRampDuration() float64 {
+	return s.birdRampDuration
+}
+
 // SetObstacleTypeWeights allows customization of obstacle type distribution
 func (s *ObstacleSpawner) SetObstacleTypeWeights(weights map[entities.ObstacleType]float64) {
 	s.typeWeights = make(map[entities.ObstacleType]float64)
@@ -297,11 +679,76 @@ func (s *ObstacleSpawner) SetObstacleTypeWeights(weights map[entities.ObstacleTy
 	}
 }
 
+// SetPracticeMode spawns only the given obstacle type, one every interval, for
+// drilling a single obstacle
+func (s *ObstacleSpawner) SetPracticeMode(obstType entities.ObstacleType, interval time.Duration) error {
+	if interval <= 0 {
+		return errors.New("practice interval must be positive")
+	}
+	s.practice = true
+	s.practiceType = obstType
+	s.practiceInterval = interval
+	s.scheduleNextSpawn()
+	return nil
+}
+
+// ClearPracticeMode goes back to spawning a random mix of obstacles
+func (s *ObstacleSpawner) ClearPracticeMode() {
+	s.practice = false
+	s.scheduleNextSpawn()
+}
+
+// GetPracticeMode returns the practiced obstacle type and interval, and whether
+// practice mode is on
+func (s *ObstacleSpawner) GetPracticeMode() (entities.ObstacleType, time.Duration, bool) {
+	return s.practiceType, s.practiceInterval, s.practice
+}
+
+// DifficultyState is a snapshot of how hard the run currently is
+type DifficultyState struct {
+	SpawnRate       float64 // Obstacles per second
+	SpeedMultiplier float64 // Obstacle speed relative to the base speed, ignoring warm-up
+	MinGap          float64 // Smallest random gap between obstacles
+	BirdWeight      float64 // Share of spawns that are birds, from 0 to 1
+}
+
+// GetDifficultyState returns the current spawn rate, speed, gap, and bird share together
+func (s *ObstacleSpawner) GetDifficultyState() DifficultyState {
+	return DifficultyState{
+		SpawnRate:       s.GetCurrentSpawnRate(),
+		SpeedMultiplier: s.GetSpeedMultiplier(),
+		MinGap:          s.GetMinGap(),
+		BirdWeight:      s.GetBirdWeight(),
+	}
+}
+
+// GetSpeedMultiplier returns how much faster than their base speed obstacles
+// currently move, ignoring warm-up
+func (s *ObstacleSpawner) GetSpeedMultiplier() float64 {
+	return s.getDifficultySpeedMultiplier()
+}
+
+// GetMinGap returns the smallest random gap currently left between obstacles
+func (s *ObstacleSpawner) GetMinGap() float64 {
+	minGap, _ := s.getGapRange()
+	return minGap
+}
+
+// GetBirdWeight returns the share of spawns that are currently birds, from 0 to 1
+func (s *ObstacleSpawner) GetBirdWeight() float64 {
+	return (birdLowWeight + birdMidWeight + birdHighWeight) * s.getBirdMultiplier()
+}
+
 // GetGameTime returns the current game time
 func (s *ObstacleSpawner) GetGameTime() float64 {
 	return s.gameTime
 }
 
+// GetDistance returns the distance the world has scrolled this run
+func (s *ObstacleSpawner) GetDistance() float64 {
+	return s.distance
+}
+
 // GetCurrentSpawnRate returns the current spawn rate for debugging/display
 func (s *ObstacleSpawner) GetCurrentSpawnRate() float64 {
 	return s.getCurrentSpawnRate()
@@ -309,9 +756,19 @@ func (s *ObstacleSpawner) GetCurrentSpawnRate() float64 {
 
 // GetNextSpawnDelay returns the time until next spawn for debugging/display
 func (s *ObstacleSpawner) GetNextSpawnDelay() time.Duration {
-	elapsed := time.Since(s.lastSpawnTime)
+	elapsed := s.clock.Now().Sub(s.lastSpawnTime)
 	if elapsed >= s.nextSpawnDelay {
 		return 0
 	}
 	return s.nextSpawnDelay - elapsed
 }
+
+// GetSpawnProgress returns how far along the wait for the next spawn is, from 0 just
+// after a spawn to 1 when the next obstacle is due
+func (s *ObstacleSpawner) GetSpawnProgress() float64 {
+	if s.nextSpawnDelay <= 0 {
+		return 1.0
+	}
+	progress := float64(s.clock.Now().Sub(s.lastSpawnTime)) / float64(s.nextSpawnDelay)
+	return math.Max(0, math.Min(1, progress))
+}
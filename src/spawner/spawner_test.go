@@ -1,8 +1,11 @@
 package spawner
 
 import (
+	"cli-dino-game/src/clock"
 	"cli-dino-game/src/engine"
 	"cli-dino-game/src/entities"
+	"math"
+	"math/rand"
 	"testing"
 	"time"
 )
@@ -71,6 +74,173 @@ func TestObstacleSpawnerUpdate(t *testing.T) {
 	}
 }
 
+func TestObstacleSpawnerSpawnTimingUsesClock(t *testing.T) {
+	config := engine.NewDefaultConfig()
+	spawner := NewObstacleSpawner(config, 80.0, 15.0)
+	fake := clock.NewFakeClock(time.Now())
+	spawner.SetClock(fake)
+	spawner.Reset()
+
+	// Nothing spawns until the scheduled delay has passed
+	delay := spawner.GetNextSpawnDelay()
+	fake.Advance(delay - time.Millisecond)
+	spawner.Update(0)
+	if spawner.GetActiveObstacleCount() != 0 {
+		t.Fatalf("Expected no obstacle before the spawn delay, got %d", spawner.GetActiveObstacleCount())
+	}
+
+	fake.Advance(time.Millisecond)
+	spawner.Update(0)
+	if spawner.GetActiveObstacleCount() != 1 {
+		t.Fatalf("Expected an obstacle once the spawn delay passed, got %d", spawner.GetActiveObstacleCount())
+	}
+
+	// The next obstacle is scheduled from the moment of this spawn
+	if spawner.GetNextSpawnDelay() != spawner.nextSpawnDelay {
+		t.Errorf("Expected the full next delay %v to remain, got %v", spawner.nextSpawnDelay, spawner.GetNextSpawnDelay())
+	}
+}
+
+func TestObstacleSpawnerPracticeMode(t *testing.T) {
+	config := engine.NewDefaultConfig()
+	spawner := NewObstacleSpawner(config, 80.0, 15.0)
+	fake := clock.NewFakeClock(time.Now())
+	spawner.SetClock(fake)
+
+	if err := spawner.SetPracticeMode(entities.BirdHigh, 0); err == nil {
+		t.Error("Expected an error for a non-positive practice interval")
+	}
+	if err := spawner.SetPracticeMode(entities.BirdHigh, time.Second); err != nil {
+		t.Fatalf("Failed to set practice mode: %v", err)
+	}
+	spawner.Reset()
+
+	// Ten seconds of frames spawn one high bird every second, well before birds
+	// would normally appear
+	var spawnTimes []time.Time
+	for i := 0; i < 100; i++ {
+		fake.Advance(100 * time.Millisecond)
+		before := spawner.lastSpawnTime
+		spawner.Update(0.1)
+		if spawner.lastSpawnTime != before {
+			spawnTimes = append(spawnTimes, spawner.lastSpawnTime)
+		}
+		for _, obstacle := range spawner.GetObstacles() {
+			if obstacle.GetType() != entities.BirdHigh {
+				t.Fatalf("Expected only high birds in practice mode, got %v", obstacle.GetType())
+			}
+		}
+	}
+
+	if len(spawnTimes) != 10 {
+		t.Fatalf("Expected 10 spawns in 10 seconds, got %d", len(spawnTimes))
+	}
+	for i := 1; i < len(spawnTimes); i++ {
+		if gap := spawnTimes[i].Sub(spawnTimes[i-1]); gap != time.Second {
+			t.Errorf("Spawn %d: expected a 1s gap, got %v", i, gap)
+		}
+	}
+
+	obstType, interval, on := spawner.GetPracticeMode()
+	if !on || obstType != entities.BirdHigh || interval != time.Second {
+		t.Errorf("Expected practice mode on for high birds every 1s, got %v %v %v", obstType, interval, on)
+	}
+
+	spawner.ClearPracticeMode()
+	if _, _, on := spawner.GetPracticeMode(); on {
+		t.Error("Expected practice mode off after clearing it")
+	}
+}
+
+func TestObstacleSpawnerSpawnProgress(t *testing.T) {
+	config := engine.NewDefaultConfig()
+	spawner := NewObstacleSpawner(config, 80.0, 15.0)
+	fake := clock.NewFakeClock(time.Now())
+	spawner.SetClock(fake)
+	spawner.Reset()
+
+	if spawner.GetSpawnProgress() != 0 {
+		t.Errorf("Expected no progress right after a reset, got %f", spawner.GetSpawnProgress())
+	}
+
+	// Progress climbs toward 1 as the spawn delay runs down
+	delay := spawner.GetNextSpawnDelay()
+	previous := 0.0
+	for i := 1; i <= 4; i++ {
+		fake.Advance(delay / 5)
+		progress := spawner.GetSpawnProgress()
+		if progress <= previous || progress >= 1 {
+			t.Errorf("Step %d: expected progress between %f and 1, got %f", i, previous, progress)
+		}
+		previous = progress
+	}
+
+	// It stays at 1 once the spawn is due, then starts over after the spawn
+	fake.Advance(delay)
+	if spawner.GetSpawnProgress() != 1 {
+		t.Errorf("Expected progress clamped to 1 when overdue, got %f", spawner.GetSpawnProgress())
+	}
+	spawner.Update(0)
+	if spawner.GetActiveObstacleCount() != 1 {
+		t.Fatalf("Expected an obstacle to spawn, got %d", spawner.GetActiveObstacleCount())
+	}
+	if spawner.GetSpawnProgress() != 0 {
+		t.Errorf("Expected progress to restart after a spawn, got %f", spawner.GetSpawnProgress())
+	}
+}
+
+func TestObstacleSpawnerFrozen(t *testing.T) {
+	config := engine.NewDefaultConfig()
+	spawner := NewObstacleSpawner(config, 80.0, 15.0)
+	fake := clock.NewFakeClock(time.Now())
+	spawner.SetClock(fake)
+	spawner.Reset()
+
+	spawner.spawnObstacle()
+	obstacle := spawner.GetObstacles()[0]
+	x := obstacle.X
+	delay := spawner.GetNextSpawnDelay()
+
+	spawner.SetFrozen(true)
+	if !spawner.IsFrozen() {
+		t.Fatal("Expected the spawner to be frozen")
+	}
+
+	// Well past the spawn delay, nothing moves and nothing spawns
+	for i := 0; i < 10; i++ {
+		fake.Advance(delay)
+		spawner.Update(0.5)
+	}
+	if obstacle.X != x {
+		t.Errorf("Expected a frozen obstacle to stay at X=%.1f, got %.1f", x, obstacle.X)
+	}
+	if spawner.GetActiveObstacleCount() != 1 {
+		t.Errorf("Expected no new obstacles while frozen, got %d", spawner.GetActiveObstacleCount())
+	}
+	if spawner.GetGameTime() != 5.0 {
+		t.Errorf("Expected game time to keep advancing while frozen, got %.1f", spawner.GetGameTime())
+	}
+	if spawner.GetDistance() != 0 {
+		t.Errorf("Expected no distance while frozen, got %.1f", spawner.GetDistance())
+	}
+
+	// Unfreezing resumes movement, and the spawn timer carries on where it stopped
+	spawner.SetFrozen(false)
+	spawner.Update(0.1)
+	if obstacle.X >= x {
+		t.Errorf("Expected the obstacle to move once unfrozen, still at X=%.1f", obstacle.X)
+	}
+	if spawner.GetActiveObstacleCount() != 1 {
+		t.Errorf("Expected the frozen time not to count toward the next spawn, got %d obstacles", spawner.GetActiveObstacleCount())
+	}
+
+	spawner.SetFrozen(true)
+	spawner.Reset()
+	if spawner.IsFrozen() {
+		t.Error("Expected reset to unfreeze the spawner")
+	}
+}
+
 func TestObstacleSpawnerSpawnObstacle(t *testing.T) {
 	config := engine.NewDefaultConfig()
 	spawner := NewObstacleSpawner(config, 80.0, 15.0)
@@ -149,6 +319,85 @@ func TestObstacleSpawnerDifficultyProgression(t *testing.T) {
 	}
 }
 
+func TestObstacleSpawnerDistanceBasedDifficulty(t *testing.T) {
+	config := engine.NewDefaultConfig()
+	timeSpawner := NewObstacleSpawner(config, 80.0, 15.0)
+	distanceSpawner := NewObstacleSpawner(config, 80.0, 15.0)
+	distanceSpawner.SetDifficultyBasis(BasisDistance)
+
+	if timeSpawner.GetDifficultyBasis() != BasisTime {
+		t.Errorf("Expected time-based difficulty by default, got %s", timeSpawner.GetDifficultyBasis())
+	}
+
+	// Simulate 60 seconds of play at 10 updates per second
+	for i := 0; i < 600; i++ {
+		timeSpawner.Update(0.1)
+		distanceSpawner.Update(0.1)
+	}
+
+	if timeSpawner.GetGameTime() != distanceSpawner.GetGameTime() {
+		t.Fatalf("Expected equal game time, got %f and %f", timeSpawner.GetGameTime(), distanceSpawner.GetGameTime())
+	}
+
+	// Speed ramps up, so the world covers more distance than base speed alone would
+	if distanceSpawner.GetDistance() <= config.ObstacleSpeed*distanceSpawner.GetGameTime() {
+		t.Errorf("Expected distance %f to exceed base speed * time %f", distanceSpawner.GetDistance(), config.ObstacleSpeed*distanceSpawner.GetGameTime())
+	}
+
+	if distanceSpawner.GetCurrentSpawnRate() <= timeSpawner.GetCurrentSpawnRate() {
+		t.Errorf("Expected distance-based spawn rate %f to exceed time-based %f",
+			distanceSpawner.GetCurrentSpawnRate(), timeSpawner.GetCurrentSpawnRate())
+	}
+	if distanceSpawner.getDifficultySpeedMultiplier() <= timeSpawner.getDifficultySpeedMultiplier() {
+		t.Errorf("Expected distance-based speed multiplier %f to exceed time-based %f",
+			distanceSpawner.getDifficultySpeedMultiplier(), timeSpawner.getDifficultySpeedMultiplier())
+	}
+
+	// Reset clears the distance traveled
+	distanceSpawner.Reset()
+	if distanceSpawner.GetDistance() != 0 {
+		t.Errorf("Expected distance reset to 0, got %f", distanceSpawner.GetDistance())
+	}
+}
+
+func TestObstacleSpawnerWarmup(t *testing.T) {
+	config := engine.NewDefaultConfig()
+	spawner := NewObstacleSpawner(config, 80.0, 15.0)
+
+	// No warm-up by default
+	if spawner.GetEffectiveObstacleSpeed() != config.ObstacleSpeed {
+		t.Errorf("Expected full speed %f without warm-up, got %f", config.ObstacleSpeed, spawner.GetEffectiveObstacleSpeed())
+	}
+
+	spawner.SetWarmupDuration(5 * time.Second)
+	if spawner.GetWarmupDuration() != 5*time.Second {
+		t.Errorf("Expected warm-up 5s, got %v", spawner.GetWarmupDuration())
+	}
+
+	// Slower at the start of the run
+	if spawner.GetEffectiveObstacleSpeed() >= config.ObstacleSpeed {
+		t.Errorf("Expected speed below %f at t=0, got %f", config.ObstacleSpeed, spawner.GetEffectiveObstacleSpeed())
+	}
+	spawner.spawnObstacle()
+	if speed := spawner.GetObstacles()[0].GetSpeed(); speed >= config.ObstacleSpeed {
+		t.Errorf("Expected first obstacle slower than %f, got %f", config.ObstacleSpeed, speed)
+	}
+
+	// Eases up during the warm-up
+	spawner.gameTime = 2.5
+	midSpeed := spawner.GetEffectiveObstacleSpeed()
+	if midSpeed <= config.ObstacleSpeed*warmupStartMultiplier || midSpeed >= config.ObstacleSpeed {
+		t.Errorf("Expected speed between start and full during warm-up, got %f", midSpeed)
+	}
+
+	// Full speed once the warm-up is over
+	spawner.gameTime = 5.0
+	expected := config.ObstacleSpeed * spawner.getDifficultySpeedMultiplier()
+	if spawner.GetEffectiveObstacleSpeed() != expected {
+		t.Errorf("Expected full speed %f after warm-up, got %f", expected, spawner.GetEffectiveObstacleSpeed())
+	}
+}
+
 func TestObstacleSpawnerMaxDifficulty(t *testing.T) {
 	config := engine.NewDefaultConfig()
 	spawner := NewObstacleSpawner(config, 80.0, 15.0)
@@ -163,61 +412,99 @@ func TestObstacleSpawnerMaxDifficulty(t *testing.T) {
 
 	// Test that speed multiplier caps at maximum
 	speed := spawner.getDifficultySpeedMultiplier()
-	maxSpeed := 2.0 // As defined in the implementation
+	maxSpeed := spawner.GetMaxSpeedMultiplier()
 
 	if speed > maxSpeed {
 		t.Errorf("Expected speed multiplier to be capped at %f, got %f", maxSpeed, speed)
 	}
 }
 
-func TestObstacleSpawnerScheduleNextSpawn(t *testing.T) {
+func TestObstacleSpawnerMaxSpeedMultiplier(t *testing.T) {
 	config := engine.NewDefaultConfig()
 	spawner := NewObstacleSpawner(config, 80.0, 15.0)
 
-	// Test that spawn delay is within reasonable bounds
-	spawner.scheduleNextSpawn()
+	if spawner.GetMaxSpeedMultiplier() != DefaultMaxSpeedMultiplier {
+		t.Errorf("Expected default cap %f, got %f", DefaultMaxSpeedMultiplier, spawner.GetMaxSpeedMultiplier())
+	}
 
-	if spawner.nextSpawnDelay < spawner.minSpawnInterval {
-		t.Errorf("Expected spawn delay >= %v, got %v", spawner.minSpawnInterval, spawner.nextSpawnDelay)
+	for _, invalid := range []float64{0.99, 0, -1} {
+		if err := spawner.SetMaxSpeedMultiplier(invalid); err == nil {
+			t.Errorf("Expected an error for cap %f", invalid)
+		}
 	}
-	if spawner.nextSpawnDelay > spawner.maxSpawnInterval {
-		t.Errorf("Expected spawn delay <= %v, got %v", spawner.maxSpawnInterval, spawner.nextSpawnDelay)
+	if spawner.GetMaxSpeedMultiplier() != DefaultMaxSpeedMultiplier {
+		t.Errorf("Expected rejected caps to leave %f, got %f", DefaultMaxSpeedMultiplier, spawner.GetMaxSpeedMultiplier())
+	}
+
+	for _, limit := range []float64{1.0, 1.25, 3.0} {
+		if err := spawner.SetMaxSpeedMultiplier(limit); err != nil {
+			t.Fatalf("Failed to set cap %f: %v", limit, err)
+		}
+		for _, gameTime := range []float64{1e3, 1e5, 1e9} {
+			spawner.gameTime = gameTime
+			if speed := spawner.getDifficultySpeedMultiplier(); speed > limit {
+				t.Errorf("Cap %f at game time %g: expected speed multiplier at most the cap, got %f", limit, gameTime, speed)
+			}
+			if speed := spawner.GetEffectiveObstacleSpeed(); speed > config.ObstacleSpeed*limit {
+				t.Errorf("Cap %f at game time %g: expected effective speed at most %f, got %f", limit, gameTime, config.ObstacleSpeed*limit, speed)
+			}
+		}
 	}
 }
 
-func TestObstacleSpawnerRemoveObstacle(t *testing.T) {
+func TestObstacleSpawnerDifficultyState(t *testing.T) {
 	config := engine.NewDefaultConfig()
 	spawner := NewObstacleSpawner(config, 80.0, 15.0)
 
-	// Add some obstacles
-	spawner.spawnObstacle()
-	spawner.spawnObstacle()
-	spawner.spawnObstacle()
-
-	initialCount := spawner.GetActiveObstacleCount()
-	if initialCount != 3 {
-		t.Fatalf("Expected 3 obstacles, got %d", initialCount)
-	}
+	previous := spawner.GetDifficultyState()
+	for _, gameTime := range []float64{0, 30, 60, 120, 1000} {
+		spawner.gameTime = gameTime
+		state := spawner.GetDifficultyState()
 
-	// Remove middle obstacle
-	spawner.removeObstacle(1)
+		if state.SpawnRate != spawner.GetCurrentSpawnRate() {
+			t.Errorf("At %gs: expected spawn rate %f, got %f", gameTime, spawner.GetCurrentSpawnRate(), state.SpawnRate)
+		}
+		if state.SpeedMultiplier != spawner.GetSpeedMultiplier() {
+			t.Errorf("At %gs: expected speed multiplier %f, got %f", gameTime, spawner.GetSpeedMultiplier(), state.SpeedMultiplier)
+		}
+		if state.MinGap != spawner.GetMinGap() {
+			t.Errorf("At %gs: expected min gap %f, got %f", gameTime, spawner.GetMinGap(), state.MinGap)
+		}
+		if state.BirdWeight != spawner.GetBirdWeight() {
+			t.Errorf("At %gs: expected bird weight %f, got %f", gameTime, spawner.GetBirdWeight(), state.BirdWeight)
+		}
 
-	if spawner.GetActiveObstacleCount() != 2 {
-		t.Errorf("Expected 2 obstacles after removal, got %d", spawner.GetActiveObstacleCount())
+		// Everything gets harder, or stays capped, as the run goes on
+		if state.SpawnRate < previous.SpawnRate || state.SpeedMultiplier < previous.SpeedMultiplier ||
+			state.MinGap > previous.MinGap || state.BirdWeight < previous.BirdWeight {
+			t.Errorf("At %gs: expected difficulty not to ease, went from %+v to %+v", gameTime, previous, state)
+		}
+		previous = state
 	}
 
-	// Remove first obstacle
-	spawner.removeObstacle(0)
-
-	if spawner.GetActiveObstacleCount() != 1 {
-		t.Errorf("Expected 1 obstacle after removal, got %d", spawner.GetActiveObstacleCount())
+	// No birds at the start of a run, and a quarter of spawns once they're at full strength
+	spawner.gameTime = 0
+	if weight := spawner.GetBirdWeight(); weight != 0 {
+		t.Errorf("Expected no birds at the start, got %f", weight)
+	}
+	spawner.gameTime = 1000
+	if weight := spawner.GetBirdWeight(); math.Abs(weight-0.25) > 1e-9 {
+		t.Errorf("Expected birds to be a quarter of spawns at full strength, got %f", weight)
 	}
+}
 
-	// Remove last obstacle
-	spawner.removeObstacle(0)
+func TestObstacleSpawnerScheduleNextSpawn(t *testing.T) {
+	config := engine.NewDefaultConfig()
+	spawner := NewObstacleSpawner(config, 80.0, 15.0)
 
-	if spawner.GetActiveObstacleCount() != 0 {
-		t.Errorf("Expected 0 obstacles after removal, got %d", spawner.GetActiveObstacleCount())
+	// Test that spawn delay is within reasonable bounds
+	spawner.scheduleNextSpawn()
+
+	if spawner.nextSpawnDelay < spawner.minSpawnInterval {
+		t.Errorf("Expected spawn delay >= %v, got %v", spawner.minSpawnInterval, spawner.nextSpawnDelay)
+	}
+	if spawner.nextSpawnDelay > spawner.maxSpawnInterval {
+		t.Errorf("Expected spawn delay <= %v, got %v", spawner.maxSpawnInterval, spawner.nextSpawnDelay)
 	}
 }
 
@@ -276,6 +563,96 @@ func TestObstacleSpawnerReset(t *testing.T) {
 	}
 }
 
+func TestObstacleSpawnerSetSeed(t *testing.T) {
+	config := engine.NewDefaultConfig()
+	spawnerA := NewObstacleSpawner(config, 80.0, 15.0)
+	spawnerB := NewObstacleSpawner(config, 80.0, 15.0)
+
+	spawnerA.SetSeed(1234)
+	spawnerB.SetSeed(1234)
+
+	// Late enough in the run that birds are in the mix
+	spawnerA.gameTime = 1000
+	spawnerB.gameTime = 1000
+
+	for i := 0; i < 10; i++ {
+		typeA := spawnerA.selectObstacleType()
+		typeB := spawnerB.selectObstacleType()
+		if typeA != typeB {
+			t.Fatalf("Spawn %d: expected identical obstacle types for the same seed, got %v and %v", i, typeA, typeB)
+		}
+
+		spawnerA.scheduleNextSpawn()
+		spawnerB.scheduleNextSpawn()
+		if spawnerA.nextSpawnDelay != spawnerB.nextSpawnDelay {
+			t.Fatalf("Spawn %d: expected identical delays for the same seed, got %v and %v",
+				i, spawnerA.nextSpawnDelay, spawnerB.nextSpawnDelay)
+		}
+
+		gapA := spawnerA.calculateSpawnPosition()
+		gapB := spawnerB.calculateSpawnPosition()
+		if gapA != gapB {
+			t.Fatalf("Spawn %d: expected identical positions for the same seed, got %f and %f", i, gapA, gapB)
+		}
+	}
+}
+
+func TestObstacleSpawnerSeededTypeSequence(t *testing.T) {
+	config := engine.NewDefaultConfig()
+
+	// sequence picks obstacle types through the bird ramp with a fresh spawner
+	sequence := func(seed int64) []entities.ObstacleType {
+		spawner := NewObstacleSpawner(config, 80.0, 15.0)
+		spawner.SetSeed(seed)
+		types := make([]entities.ObstacleType, 0, 500)
+		for i := 0; i < 500; i++ {
+			spawner.gameTime = float64(i) * 0.2
+			types = append(types, spawner.selectObstacleType())
+		}
+		return types
+	}
+
+	first := sequence(99)
+	for run := 0; run < 5; run++ {
+		again := sequence(99)
+		for i := range first {
+			if again[i] != first[i] {
+				t.Fatalf("Run %d, spawn %d: expected %v for the same seed, got %v", run, i, first[i], again[i])
+			}
+		}
+	}
+
+	// The sequence still mixes cacti and birds
+	seen := make(map[entities.ObstacleType]bool)
+	for _, obstType := range first {
+		seen[obstType] = true
+	}
+	if !seen[entities.CactusSmall] || !seen[entities.BirdLow] {
+		t.Errorf("Expected both cacti and birds over the run, got %v", seen)
+	}
+}
+
+func TestObstacleSpawnerTypeWeightsOrder(t *testing.T) {
+	config := engine.NewDefaultConfig()
+	spawner := NewObstacleSpawner(config, 80.0, 15.0)
+
+	for _, gameTime := range []float64{0, 40, 1000} {
+		spawner.gameTime = gameTime
+		weights := spawner.getTypeWeights()
+
+		total := 0.0
+		for i, tw := range weights {
+			if tw.obstType != entities.ObstacleType(i) {
+				t.Errorf("Game time %g: expected %v at position %d, got %v", gameTime, entities.ObstacleType(i), i, tw.obstType)
+			}
+			total += tw.weight
+		}
+		if math.Abs(total-1) > 1e-9 {
+			t.Errorf("Game time %g: expected weights summing to 1, got %f", gameTime, total)
+		}
+	}
+}
+
 func TestObstacleSpawnerSetDifficulty(t *testing.T) {
 	config := engine.NewDefaultConfig()
 	spawner := NewObstacleSpawner(config, 80.0, 15.0)
@@ -473,3 +850,520 @@ func TestObstacleSpawnerSpawnPattern(t *testing.T) {
 		}
 	}
 }
+
+func TestObstacleSpawnerGetNearestObstacleAhead(t *testing.T) {
+	config := engine.NewDefaultConfig()
+	groundLevel := 15.0
+	spawner := NewObstacleSpawner(config, 80.0, groundLevel)
+
+	if spawner.GetNearestObstacleAhead(20.0) != nil {
+		t.Error("Expected no obstacle ahead with no obstacles spawned")
+	}
+
+	behind := entities.NewObstacle(entities.CactusSmall, 10.0, groundLevel, config)
+	far := entities.NewObstacle(entities.CactusSmall, 60.0, groundLevel, config)
+	near := entities.NewObstacle(entities.CactusSmall, 30.0, groundLevel, config)
+	spawner.obstacles = append(spawner.obstacles, behind, far, near)
+
+	if got := spawner.GetNearestObstacleAhead(20.0); got != near {
+		t.Errorf("Expected the obstacle at X=30 to be nearest, got %v", got)
+	}
+
+	near.Deactivate()
+	if got := spawner.GetNearestObstacleAhead(20.0); got != far {
+		t.Errorf("Expected inactive obstacles to be skipped, got %v", got)
+	}
+}
+
+func TestObstacleSpawnerSetSpawnIntervalRange(t *testing.T) {
+	config := engine.NewDefaultConfig()
+	spawner := NewObstacleSpawner(config, 80.0, 15.0)
+	spawner.SetSeed(7)
+
+	defaultMin, defaultMax := spawner.GetSpawnIntervalRange()
+	invalid := []struct{ min, max time.Duration }{
+		{0, time.Second},
+		{-time.Second, time.Second},
+		{time.Second, time.Second},
+		{2 * time.Second, time.Second},
+	}
+	for _, r := range invalid {
+		if err := spawner.SetSpawnIntervalRange(r.min, r.max); err == nil {
+			t.Errorf("Expected an error for interval range %v-%v", r.min, r.max)
+		}
+	}
+	if min, max := spawner.GetSpawnIntervalRange(); min != defaultMin || max != defaultMax {
+		t.Errorf("Expected rejected ranges to leave %v-%v, got %v-%v", defaultMin, defaultMax, min, max)
+	}
+
+	if err := spawner.SetSpawnIntervalRange(time.Second, 1500*time.Millisecond); err != nil {
+		t.Fatalf("Unexpected error setting interval range: %v", err)
+	}
+
+	// A very fast spawn rate is held back by the custom minimum
+	spawner.SetSpawnRate(100)
+	for i := 0; i < 20; i++ {
+		spawner.scheduleNextSpawn()
+		if spawner.nextSpawnDelay != time.Second {
+			t.Fatalf("Expected a fast rate to clamp to 1s, got %v", spawner.nextSpawnDelay)
+		}
+	}
+
+	// A very slow spawn rate is hurried along by the custom maximum
+	spawner.SetSpawnRate(0.01)
+	for i := 0; i < 20; i++ {
+		spawner.scheduleNextSpawn()
+		if spawner.nextSpawnDelay != 1500*time.Millisecond {
+			t.Fatalf("Expected a slow rate to clamp to 1.5s, got %v", spawner.nextSpawnDelay)
+		}
+	}
+
+	// In-range intervals are left alone
+	if err := spawner.SetSpawnIntervalRange(100*time.Millisecond, 10*time.Second); err != nil {
+		t.Fatalf("Unexpected error setting interval range: %v", err)
+	}
+	spawner.SetSpawnRate(1)
+	for i := 0; i < 20; i++ {
+		spawner.scheduleNextSpawn()
+		if d := spawner.nextSpawnDelay; d < 700*time.Millisecond || d > 1300*time.Millisecond {
+			t.Errorf("Expected an unclamped interval around 1s, got %v", d)
+		}
+	}
+}
+
+func TestObstacleSpawnerSetSpawnGapRange(t *testing.T) {
+	config := engine.NewDefaultConfig()
+	spawner := NewObstacleSpawner(config, 80.0, 15.0)
+	spawner.SetSeed(7)
+
+	min, max := spawner.GetSpawnGapRange()
+	if min != 25.0 || max != 60.0 {
+		t.Errorf("Expected default gap range 25-60, got %.1f-%.1f", min, max)
+	}
+
+	// Invalid ranges are rejected and leave the range unchanged
+	invalid := []struct{ min, max float64 }{{0, 10}, {-5, 10}, {20, 20}, {30, 10}}
+	for _, r := range invalid {
+		if err := spawner.SetSpawnGapRange(r.min, r.max); err == nil {
+			t.Errorf("Expected an error for gap range %.1f-%.1f", r.min, r.max)
+		}
+	}
+	if min, max := spawner.GetSpawnGapRange(); min != 25.0 || max != 60.0 {
+		t.Errorf("Expected rejected ranges to leave 25-60, got %.1f-%.1f", min, max)
+	}
+
+	// Every gap from an empty field falls within a tight configured range
+	if err := spawner.SetSpawnGapRange(5, 10); err != nil {
+		t.Fatalf("Unexpected error setting gap range: %v", err)
+	}
+	baseSpawnX := spawner.screenWidth + 2.0
+	for i := 0; i < 1000; i++ {
+		gap := spawner.calculateSpawnPosition() - baseSpawnX
+		if gap < 5 || gap > 10 {
+			t.Fatalf("Spawn %d: gap %.2f outside configured range 5-10", i, gap)
+		}
+	}
+}
+
+// holdSpawns keeps the spawner from spawning so Update only moves and retires obstacles
+func holdSpawns(spawner *ObstacleSpawner) {
+	spawner.lastSpawnTime = spawner.clock.Now()
+	spawner.nextSpawnDelay = time.Hour
+}
+
+func TestObstacleSpawnerUpdatePreservesOrder(t *testing.T) {
+	config := engine.NewDefaultConfig()
+	spawner := NewObstacleSpawner(config, 80.0, 15.0)
+	holdSpawns(spawner)
+
+	var all []*entities.Obstacle
+	for i := 0; i < 6; i++ {
+		obstacle := entities.NewObstacle(entities.CactusSmall, float64(10+i*10), 15.0, config)
+		all = append(all, obstacle)
+		spawner.obstacles = append(spawner.obstacles, obstacle)
+	}
+	all[0].Deactivate()
+	all[2].Deactivate()
+	all[3].Deactivate()
+
+	spawner.Update(0)
+
+	expected := []*entities.Obstacle{all[1], all[4], all[5]}
+	obstacles := spawner.GetObstacles()
+	if len(obstacles) != len(expected) {
+		t.Fatalf("Expected %d obstacles after compaction, got %d", len(expected), len(obstacles))
+	}
+	for i, obstacle := range obstacles {
+		if obstacle != expected[i] {
+			t.Errorf("Obstacle %d: expected X=%.0f, got X=%.0f", i, expected[i].X, obstacle.X)
+		}
+	}
+}
+
+func TestObstacleSpawnerReusesRetiredObstacles(t *testing.T) {
+	config := engine.NewDefaultConfig()
+	spawner := NewObstacleSpawner(config, 80.0, 15.0)
+
+	spawner.spawnObstacle()
+	first := spawner.GetObstacles()[0]
+
+	// Dirty the obstacle the way a run would, then retire it
+	first.AnimFrame = 1
+	first.MarkScored()
+	first.SetPosition(-20, 3)
+	first.SetSpeed(99)
+	first.Deactivate()
+	holdSpawns(spawner)
+	spawner.Update(0)
+
+	if spawner.GetFreeObstacleCount() != 1 {
+		t.Fatalf("Expected the retired obstacle on the free list, got %d", spawner.GetFreeObstacleCount())
+	}
+
+	spawner.spawnObstacle()
+	reused := spawner.GetObstacles()[0]
+
+	if reused != first {
+		t.Fatal("Expected the spawner to reuse the retired obstacle")
+	}
+	if spawner.GetFreeObstacleCount() != 0 {
+		t.Errorf("Expected the free list to be empty after reuse, got %d", spawner.GetFreeObstacleCount())
+	}
+
+	// The reused obstacle must match a fresh one of whatever type was picked
+	fresh := entities.NewObstacle(reused.GetType(), reused.X, 15.0, config)
+	if reused.X < spawner.screenWidth {
+		t.Errorf("Expected reused obstacle to spawn off-screen, got X=%.1f", reused.X)
+	}
+	if reused.Y != fresh.Y {
+		t.Errorf("Expected reused obstacle at Y=%.1f, got %.1f", fresh.Y, reused.Y)
+	}
+	if reused.Width != fresh.Width || reused.Height != fresh.Height {
+		t.Errorf("Expected reused obstacle size %.0fx%.0f, got %.0fx%.0f", fresh.Width, fresh.Height, reused.Width, reused.Height)
+	}
+	if !reused.IsActive() {
+		t.Error("Expected reused obstacle to be active")
+	}
+	if reused.IsScored() {
+		t.Error("Expected reused obstacle not to be scored")
+	}
+	if reused.AnimFrame != 0 {
+		t.Errorf("Expected reused obstacle animation frame 0, got %d", reused.AnimFrame)
+	}
+	if reused.GetSpeed() != spawner.GetEffectiveObstacleSpeed() {
+		t.Errorf("Expected reused obstacle speed %.2f, got %.2f", spawner.GetEffectiveObstacleSpeed(), reused.GetSpeed())
+	}
+}
+
+func TestObstacleSpawnerPrewarm(t *testing.T) {
+	config := engine.NewDefaultConfig()
+	spawner := NewObstacleSpawner(config, 80.0, 15.0)
+	spawner.Prewarm(3)
+
+	if spawner.GetFreeObstacleCount() != 3 {
+		t.Fatalf("Expected 3 prewarmed obstacles, got %d", spawner.GetFreeObstacleCount())
+	}
+	if spawner.GetActiveObstacleCount() != 0 {
+		t.Errorf("Expected prewarming not to spawn obstacles, got %d", spawner.GetActiveObstacleCount())
+	}
+
+	spawner.spawnObstacle()
+	if spawner.GetFreeObstacleCount() != 2 {
+		t.Errorf("Expected a spawn to take from the free list, got %d free", spawner.GetFreeObstacleCount())
+	}
+
+	// Resetting the spawner retires every obstacle for the next run
+	spawner.Reset()
+	if spawner.GetFreeObstacleCount() != 3 {
+		t.Errorf("Expected reset to return obstacles to the free list, got %d free", spawner.GetFreeObstacleCount())
+	}
+}
+
+func BenchmarkObstacleSpawnerCompactObstacles(b *testing.B) {
+	config := engine.NewDefaultConfig()
+	spawner := NewObstacleSpawner(config, 80.0, 15.0)
+	holdSpawns(spawner)
+	pool := make([]*entities.Obstacle, 64)
+	for i := range pool {
+		pool[i] = entities.NewObstacle(entities.CactusSmall, float64(i), 15.0, config)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		// Refill with every other obstacle inactive, then retire them
+		spawner.obstacles = spawner.obstacles[:0]
+		spawner.free = spawner.free[:0]
+		for j, obstacle := range pool {
+			obstacle.Active = j%2 == 0
+			spawner.obstacles = append(spawner.obstacles, obstacle)
+		}
+		spawner.Update(0)
+	}
+}
+
+// legacyUpdate is the spawner update loop before the single-pass fast path, kept
+// to check that the fast path behaves the same
+func legacyUpdate(s *ObstacleSpawner, deltaTime float64) {
+	s.gameTime += deltaTime
+	if s.frozen {
+		return
+	}
+	s.distance += s.GetEffectiveObstacleSpeed() * deltaTime
+	if s.clock.Now().Sub(s.lastSpawnTime) >= s.nextSpawnDelay {
+		s.spawnObstacle()
+		s.scheduleNextSpawn()
+	}
+	for _, obstacle := range s.obstacles {
+		obstacle.Update(deltaTime)
+	}
+
+	// Retire inactive obstacles in a second pass
+	kept := s.obstacles[:0]
+	for _, obstacle := range s.obstacles {
+		if obstacle.IsActive() {
+			kept = append(kept, obstacle)
+		} else {
+			s.free = append(s.free, obstacle)
+		}
+	}
+	s.clearTail(kept)
+}
+
+func TestObstacleSpawnerUpdateMatchesLegacyLoop(t *testing.T) {
+	config := engine.NewDefaultConfig()
+	start := time.Now()
+	types := []entities.ObstacleType{entities.CactusSmall, entities.BirdLow, entities.CactusMedium,
+		entities.BirdMid, entities.CactusLarge, entities.BirdHigh}
+
+	// Two spawners holding identical obstacles scattered by a fixed seed, some
+	// close enough to the left edge to retire during the run
+	newSpawner := func() (*ObstacleSpawner, *clock.FakeClock) {
+		fake := clock.NewFakeClock(start)
+		spawner := NewObstacleSpawner(config, 80.0, 15.0)
+		spawner.SetClock(fake)
+		rng := rand.New(rand.NewSource(42))
+		for i := 0; i < 100; i++ {
+			obstacle := entities.NewObstacle(types[i%len(types)], rng.Float64()*200-10, 15.0, config)
+			obstacle.SetClock(fake)
+			spawner.obstacles = append(spawner.obstacles, obstacle)
+		}
+		holdSpawns(spawner)
+		return spawner, fake
+	}
+	fast, fastClock := newSpawner()
+	legacy, legacyClock := newSpawner()
+
+	const dt = 1.0 / 60
+	for frame := 0; frame < 300; frame++ {
+		fastClock.Advance(time.Second / 60)
+		legacyClock.Advance(time.Second / 60)
+		fast.Update(dt)
+		legacyUpdate(legacy, dt)
+
+		if len(fast.obstacles) != len(legacy.obstacles) || len(fast.free) != len(legacy.free) {
+			t.Fatalf("Frame %d: expected %d active and %d free obstacles, got %d and %d",
+				frame, len(legacy.obstacles), len(legacy.free), len(fast.obstacles), len(fast.free))
+		}
+		for i, want := range legacy.obstacles {
+			got := fast.obstacles[i]
+			if got.X != want.X || got.GetType() != want.GetType() || got.AnimFrame != want.AnimFrame {
+				t.Fatalf("Frame %d obstacle %d: expected %v at %.3f frame %d, got %v at %.3f frame %d",
+					frame, i, want.GetType(), want.X, want.AnimFrame, got.GetType(), got.X, got.AnimFrame)
+			}
+		}
+	}
+	if len(fast.free) == 0 || len(fast.obstacles) == 0 {
+		t.Errorf("Expected the run to retire some obstacles and keep others, got %d free and %d active",
+			len(fast.free), len(fast.obstacles))
+	}
+}
+
+// resetBenchmarkObstacles puts every obstacle in the pool back on screen, a mix of
+// cacti and birds, and holds off spawning so only the update loop is measured
+func resetBenchmarkObstacles(spawner *ObstacleSpawner, pool []*entities.Obstacle) {
+	spawner.obstacles = append(spawner.obstacles[:0], pool...)
+	spawner.free = spawner.free[:0]
+	for i, obstacle := range pool {
+		obstacle.Active = true
+		obstacle.X = 400 + float64(i)*10
+	}
+	holdSpawns(spawner)
+}
+
+func BenchmarkSpawnerUpdate(b *testing.B) {
+	config := engine.NewDefaultConfig()
+	spawner := NewObstacleSpawner(config, 80.0, 15.0)
+	types := []entities.ObstacleType{entities.CactusSmall, entities.BirdLow, entities.CactusLarge, entities.BirdHigh}
+	pool := make([]*entities.Obstacle, 100)
+	for i := range pool {
+		pool[i] = entities.NewObstacle(types[i%len(types)], 0, 15.0, config)
+	}
+	resetBenchmarkObstacles(spawner, pool)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if i%1000 == 999 {
+			b.StopTimer()
+			resetBenchmarkObstacles(spawner, pool)
+			b.StartTimer()
+		}
+		spawner.Update(1.0 / 60)
+	}
+}
+
+func BenchmarkObstacleSpawnerSpawnAndRetire(b *testing.B) {
+	config := engine.NewDefaultConfig()
+	spawner := NewObstacleSpawner(config, 80.0, 15.0)
+	holdSpawns(spawner)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		spawner.spawnObstacle()
+
+		// Retire obstacles as they would leave the screen
+		if len(spawner.obstacles) > 4 {
+			spawner.obstacles[0].Deactivate()
+			spawner.Update(0)
+		}
+	}
+}
+
+func TestObstacleSpawnerBirdIntroTime(t *testing.T) {
+	config := engine.NewDefaultConfig()
+	spawner := NewObstacleSpawner(config, 80.0, 15.0)
+	spawner.SetSeed(3)
+	spawner.SetBirdIntroTime(30)
+	spawner.SetBirdRampDuration(20)
+
+	if spawner.GetBirdIntroTime() != 30 || spawner.GetBirdRampDuration() != 20 {
+		t.Fatalf("Expected intro 30s and ramp 20s, got %.1f and %.1f",
+			spawner.GetBirdIntroTime(), spawner.GetBirdRampDuration())
+	}
+
+	isBird := func(obstType entities.ObstacleType) bool {
+		return obstType == entities.BirdLow || obstType == entities.BirdMid || obstType == entities.BirdHigh
+	}
+
+	// No birds before the intro time, even just short of it
+	for _, progress := range []float64{0, 10, 29.9, 30} {
+		spawner.gameTime = progress
+		for i := 0; i < 500; i++ {
+			if obstType := spawner.selectObstacleType(); isBird(obstType) {
+				t.Fatalf("Expected no birds at %.1fs, got %v", progress, obstType)
+			}
+		}
+	}
+
+	// Birds ramp in after the intro time and are at full weighting once the ramp ends
+	tests := []struct {
+		progress float64
+		expected float64
+	}{
+		{30, 0},
+		{40, 0.5},
+		{50, 1},
+		{120, 1},
+	}
+	for _, tt := range tests {
+		spawner.gameTime = tt.progress
+		if got := spawner.getBirdMultiplier(); math.Abs(got-tt.expected) > 1e-9 {
+			t.Errorf("At %.0fs expected bird weighting %.2f, got %.2f", tt.progress, tt.expected, got)
+		}
+	}
+
+	spawner.gameTime = 120
+	birds := 0
+	for i := 0; i < 2000; i++ {
+		if isBird(spawner.selectObstacleType()) {
+			birds++
+		}
+	}
+	if birds == 0 {
+		t.Error("Expected birds once the ramp has finished")
+	}
+
+	// Without a ramp birds arrive at full weighting
+	spawner.SetBirdRampDuration(0)
+	spawner.gameTime = 30.1
+	if got := spawner.getBirdMultiplier(); got != 1 {
+		t.Errorf("Expected full bird weighting right after the intro with no ramp, got %.2f", got)
+	}
+
+	// Negative values clamp to zero
+	spawner.SetBirdIntroTime(-5)
+	spawner.SetBirdRampDuration(-5)
+	if spawner.GetBirdIntroTime() != 0 || spawner.GetBirdRampDuration() != 0 {
+		t.Error("Expected negative bird timings to clamp to zero")
+	}
+}
+
+func TestObstacleSpawnerGroundJitter(t *testing.T) {
+	config := engine.NewDefaultConfig()
+	groundLevel := 15.0
+	spawner := NewObstacleSpawner(config, 80.0, groundLevel)
+	spawner.SetSeed(1)
+
+	if spawner.GetGroundJitter() != 0 {
+		t.Errorf("Expected jitter off by default, got %d", spawner.GetGroundJitter())
+	}
+	for _, invalid := range []int{-1, MaxGroundJitter + 1} {
+		if err := spawner.SetGroundJitter(invalid); err == nil {
+			t.Errorf("Expected an error for jitter %d", invalid)
+		}
+	}
+
+	// spawnOffsets spawns obstacles of one type and returns how far each sits
+	// from the ground line, checking its bounds follow it
+	spawnOffsets := func(obstType entities.ObstacleType) map[float64]bool {
+		spawner.SetPracticeMode(obstType, time.Second)
+		baseY := entities.NewObstacle(obstType, 0, groundLevel, config).Y
+		offsets := make(map[float64]bool)
+		for i := 0; i < 200; i++ {
+			spawner.spawnObstacle()
+			obstacle := spawner.GetObstacles()[len(spawner.GetObstacles())-1]
+			offsets[obstacle.Y-baseY] = true
+
+			bounds := obstacle.GetBounds()
+			if bounds.Y != obstacle.Y+config.ObstacleHitboxInset || bounds.Height != obstacle.Height-2*config.ObstacleHitboxInset {
+				t.Fatalf("Expected bounds to follow the obstacle at y=%f, got %v", obstacle.Y, bounds)
+			}
+		}
+		spawner.Reset()
+		return offsets
+	}
+
+	// Off, cacti sit on the ground line
+	if offsets := spawnOffsets(entities.CactusSmall); len(offsets) != 1 || !offsets[0] {
+		t.Errorf("Expected every cactus on the ground line with jitter off, got offsets %v", offsets)
+	}
+
+	if err := spawner.SetGroundJitter(2); err != nil {
+		t.Fatalf("Failed to set jitter: %v", err)
+	}
+
+	// Small cacti use the full range, in whole rows
+	offsets := spawnOffsets(entities.CactusSmall)
+	for _, expected := range []float64{-2, -1, 0, 1, 2} {
+		if !offsets[expected] {
+			t.Errorf("Expected a small cactus offset by %g, got offsets %v", expected, offsets)
+		}
+	}
+	if len(offsets) != 5 {
+		t.Errorf("Expected offsets within 2 rows, got %v", offsets)
+	}
+
+	// Large cacti are already as tall as a jump clears, so they're only buried
+	for offset := range spawnOffsets(entities.CactusLarge) {
+		if offset < 0 || offset > 2 {
+			t.Errorf("Expected large cacti only buried up to 2 rows, got offset %g", offset)
+		}
+	}
+
+	// Birds keep their heights
+	if offsets := spawnOffsets(entities.BirdMid); len(offsets) != 1 || !offsets[0] {
+		t.Errorf("Expected birds unaffected by jitter, got offsets %v", offsets)
+	}
+}
@@ -0,0 +1,21 @@
+//go:build !windows
+
+package main
+
+import (
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+// notifySuspend delivers SIGTSTP to c. The terminal sends it for Ctrl+Z outside
+// raw mode, and it can also come from kill -TSTP.
+func notifySuspend(c chan<- os.Signal) {
+	signal.Notify(c, syscall.SIGTSTP)
+}
+
+// stopProcess stops the game's process group the way Ctrl+Z would, returning
+// once the shell continues it with SIGCONT (fg or bg)
+var stopProcess = func() {
+	syscall.Kill(0, syscall.SIGSTOP)
+}
@@ -0,0 +1,11 @@
+//go:build windows
+
+package main
+
+import "os"
+
+// notifySuspend does nothing; Windows consoles have no job control
+func notifySuspend(c chan<- os.Signal) {}
+
+// stopProcess returns straight away; Windows consoles have no job control
+var stopProcess = func() {}